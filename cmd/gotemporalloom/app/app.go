@@ -1 +1,12 @@
 package app
+
+import "context"
+
+// App is a long-running component started and stopped by main.
+type App interface {
+	// Start starts the app without blocking. Errors returned from Start abort the process.
+	Start(ctx context.Context) error
+
+	// Stop gracefully stops the app, giving up when ctx is done.
+	Stop(ctx context.Context) error
+}
@@ -0,0 +1,93 @@
+package app
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	logModel "github.com/nash-567/goTemporalLoom/pkg/logger/model"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/codec"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/codec/blobstore"
+	codecModel "github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/codec/model"
+)
+
+// CodecServerApp serves the remote codec endpoints used by the Temporal UI and CLI to decode payloads.
+type CodecServerApp struct {
+	log    logModel.Logger
+	config *codecModel.ServerConfig
+	codecs *codecModel.Config
+	server *http.Server
+}
+
+// NewCodecServerApp builds a CodecServerApp from command line arguments.
+// The auth token is read from the CODEC_AUTH_TOKEN environment variable so that it does not show up in process lists.
+func NewCodecServerApp(log logModel.Logger, args []string) (*CodecServerApp, error) {
+	config := &codecModel.ServerConfig{AuthToken: os.Getenv("CODEC_AUTH_TOKEN")}
+	codecs := &codecModel.Config{}
+	origins := ""
+	claimCheckDir := ""
+	claimCheckThreshold := 0
+
+	flags := flag.NewFlagSet("codec-server", flag.ContinueOnError)
+	flags.StringVar(&config.Address, "addr", codecModel.DefaultServerAddress, "address to listen on")
+	flags.StringVar(&origins, "origins", "http://localhost:8200", "comma separated list of allowed CORS origins")
+	flags.StringVar(&config.AuthHeader, "auth-header", codecModel.DefaultAuthHeader, "header checked for CODEC_AUTH_TOKEN")
+	flags.BoolVar(&codecs.Compression, "compression", false, "enable the zlib compression codec")
+	addClaimCheckFlags(flags, &claimCheckDir, &claimCheckThreshold)
+	if err := flags.Parse(args); err != nil {
+		return nil, fmt.Errorf("parse codec-server flags: %w", err)
+	}
+	if claimCheckDir != "" {
+		var err error
+		if codecs.ClaimCheck, err = newClaimCheckConfig(claimCheckDir, claimCheckThreshold); err != nil {
+			return nil, err
+		}
+	}
+	if origins != "" {
+		config.AllowedOrigins = strings.Split(origins, ",")
+	}
+
+	return &CodecServerApp{
+		log:    log,
+		config: config,
+		codecs: codecs,
+	}, nil
+}
+
+func (a *CodecServerApp) Start(_ context.Context) error {
+	server, err := serveHTTP(a.log, "codec server", a.config.GetAddress(), codec.NewHandler(a.config, codec.NewChain(a.codecs)...))
+	if err != nil {
+		return err
+	}
+	a.server = server
+	return nil
+}
+
+func (a *CodecServerApp) Stop(ctx context.Context) error {
+	if a.server == nil {
+		return nil
+	}
+	if err := a.server.Shutdown(ctx); err != nil {
+		return fmt.Errorf("shutdown codec server: %w", err)
+	}
+	return nil
+}
+
+// addClaimCheckFlags defines the flags configuring the claim check codec, which must match between the
+// worker and the codec server.
+func addClaimCheckFlags(flags *flag.FlagSet, dir *string, threshold *int) {
+	flags.StringVar(dir, "claim-check-dir", "", "directory of the file blob store used by the claim check codec, empty disables it")
+	flags.IntVar(threshold, "claim-check-threshold", codecModel.DefaultClaimCheckThreshold,
+		"encoded payload size in bytes above which payloads are offloaded to the claim check store")
+}
+
+func newClaimCheckConfig(dir string, threshold int) (*codecModel.ClaimCheckConfig, error) {
+	store, err := blobstore.NewFileStore(dir)
+	if err != nil {
+		return nil, fmt.Errorf("open claim check store: %w", err)
+	}
+	return &codecModel.ClaimCheckConfig{Store: store, Threshold: threshold}, nil
+}
@@ -0,0 +1,35 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	logModel "github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+const readHeaderTimeout = 5 * time.Second
+
+// serveHTTP listens on address and serves handler in the background.
+// Listen errors are returned, errors while serving are logged.
+func serveHTTP(log logModel.Logger, name, address string, handler http.Handler) (*http.Server, error) {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("listen on %s: %w", address, err)
+	}
+
+	server := &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.WithError(err).Error(name + " stopped unexpectedly")
+		}
+	}()
+
+	log.WithField("address", listener.Addr().String()).Info(name + " started")
+	return server, nil
+}
@@ -0,0 +1,21 @@
+package app
+
+import (
+	"errors"
+
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal"
+)
+
+var errEmptyRegistry = errors.New("no workflows registered: add them in cmd/gotemporalloom/app/registry.go")
+
+// newRegistry returns the workflows and activities served by the worker.
+// The replay command checks histories against the same registry, so every workflow must be added here.
+// It fails while no workflow is registered, as a worker polling for nothing and a replay passing nothing
+// would both look healthy.
+func newRegistry() (*temporal.Registry, error) {
+	registry := temporal.NewRegistry()
+	if len(registry.Workflows()) == 0 {
+		return nil, errEmptyRegistry
+	}
+	return registry, nil
+}
@@ -0,0 +1,56 @@
+package app
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+
+	sdkLog "go.temporal.io/sdk/log"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/replay"
+)
+
+var (
+	errNoHistories    = errors.New("no history files or directories given")
+	errReplayFailures = errors.New("histories failed to replay")
+)
+
+// RunReplay replays the workflow histories given on the command line against the worker's registry
+// and writes one PASS or FAIL line per history to out.
+func RunReplay(log *logger.SlogLogger, args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("replay", flag.ContinueOnError)
+	verbose := flags.Bool("v", false, "log replayer output")
+	if err := flags.Parse(args); err != nil {
+		return fmt.Errorf("parse replay flags: %w", err)
+	}
+	if flags.NArg() == 0 {
+		return errNoHistories
+	}
+
+	registry, err := newRegistry()
+	if err != nil {
+		return err
+	}
+	histories, err := replay.Load(flags.Args()...)
+	if err != nil {
+		return fmt.Errorf("load histories: %w", err)
+	}
+
+	var replayLogger sdkLog.Logger
+	if *verbose {
+		replayLogger = logger.NewTemporalLogger(log)
+	}
+	failed := 0
+	for _, result := range replay.Run(registry, histories, replayLogger) {
+		if !result.Passed() {
+			failed++
+		}
+		fmt.Fprintln(out, result.String())
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d %w", failed, len(histories), errReplayFailures)
+	}
+	return nil
+}
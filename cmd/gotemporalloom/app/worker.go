@@ -0,0 +1,182 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"go.temporal.io/sdk/client"
+	sdkInterceptor "go.temporal.io/sdk/interceptor"
+	"go.temporal.io/sdk/worker"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/codec"
+	codecModel "github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/codec/model"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/interceptor"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/metrics"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/tracing"
+)
+
+const (
+	defaultTemporalAddress = "localhost:7235"
+	defaultNamespace       = "default"
+	defaultTaskQueue       = "gotemporalloom"
+	// /loglevel is unauthenticated, so the admin server only listens on the loopback interface by default.
+	defaultAdminAddress  = "localhost:9090"
+	defaultClaimCheckTTL = 30 * 24 * time.Hour
+	claimCheckGCInterval = time.Hour
+)
+
+// WorkerApp runs a Temporal worker and an admin HTTP server exposing /metrics and /loglevel.
+// The log level can also be stepped down with SIGUSR1 and up with SIGUSR2.
+// When the claim check codec is enabled, the worker also deletes the offloaded payloads older than its TTL.
+type WorkerApp struct {
+	log              *logger.SlogLogger
+	temporalAddr     string
+	namespace        string
+	taskQueue        string
+	adminAddr        string
+	logLevelTTL      time.Duration
+	codecs           *codecModel.Config
+	claimCheckTTL    time.Duration
+	stopClaimCheckGC func()
+	client           client.Client
+	worker           worker.Worker
+	admin            *http.Server
+	levels           *logger.LevelController
+	stopLevelSignals func()
+}
+
+// NewWorkerApp builds a WorkerApp from command line arguments.
+// The Temporal address defaults to the TEMPORAL_ADDRESS environment variable.
+func NewWorkerApp(log *logger.SlogLogger, args []string) (*WorkerApp, error) {
+	a := &WorkerApp{
+		log:    log,
+		codecs: &codecModel.Config{},
+	}
+
+	temporalAddr := os.Getenv("TEMPORAL_ADDRESS")
+	if temporalAddr == "" {
+		temporalAddr = defaultTemporalAddress
+	}
+
+	flags := flag.NewFlagSet("worker", flag.ContinueOnError)
+	flags.StringVar(&a.temporalAddr, "temporal-address", temporalAddr, "host:port of the Temporal frontend")
+	flags.StringVar(&a.namespace, "namespace", defaultNamespace, "Temporal namespace")
+	flags.StringVar(&a.taskQueue, "task-queue", defaultTaskQueue, "task queue polled by the worker")
+	flags.StringVar(&a.adminAddr, "admin-addr", defaultAdminAddress, "address of the admin server exposing /metrics and the unauthenticated /loglevel")
+	flags.DurationVar(&a.logLevelTTL, "log-level-ttl", 0, "revert runtime log level changes after this duration, 0 keeps them")
+	flags.BoolVar(&a.codecs.Compression, "compression", false, "enable the zlib compression codec")
+	claimCheckDir, claimCheckThreshold := "", 0
+	addClaimCheckFlags(flags, &claimCheckDir, &claimCheckThreshold)
+	flags.DurationVar(&a.claimCheckTTL, "claim-check-ttl", defaultClaimCheckTTL,
+		"delete offloaded payloads stored longer ago than this, it must exceed the namespace retention, 0 keeps them")
+	if err := flags.Parse(args); err != nil {
+		return nil, fmt.Errorf("parse worker flags: %w", err)
+	}
+	if claimCheckDir != "" {
+		var err error
+		if a.codecs.ClaimCheck, err = newClaimCheckConfig(claimCheckDir, claimCheckThreshold); err != nil {
+			return nil, err
+		}
+	}
+	return a, nil
+}
+
+func (a *WorkerApp) Start(_ context.Context) error {
+	registry, err := newRegistry()
+	if err != nil {
+		return err
+	}
+	dataConverter := codec.NewDataConverter(a.codecs)
+	// named loggers, so that e.g. orchestrator.temporal.sdk=warn quiets the SDK without quieting the workflows.
+	// They decode the details of Temporal errors as the client encodes them.
+	temporalLog := a.log.Named("orchestrator").Named("temporal").WithDataConverter(dataConverter)
+	m := metrics.New(&metrics.Config{
+		Workflows:  registry.Workflows(),
+		Activities: registry.Activities(),
+		Log:        temporalLog.Named("metrics"),
+	})
+
+	c, err := client.Dial(client.Options{
+		HostPort:       a.temporalAddr,
+		Namespace:      a.namespace,
+		Logger:         logger.NewTemporalLogger(temporalLog.Named("sdk")),
+		MetricsHandler: m.Handler(),
+		DataConverter:  dataConverter,
+		// interceptors that also implement the worker interceptor are applied to the worker as well.
+		Interceptors: []sdkInterceptor.ClientInterceptor{
+			interceptor.NewLoggingInterceptor(temporalLog.Named("interceptor")),
+			tracing.NewInterceptor(&tracing.Config{}),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("dial temporal: %w", err)
+	}
+	a.client = c
+
+	a.worker = worker.New(c, a.taskQueue, worker.Options{
+		Interceptors: []sdkInterceptor.WorkerInterceptor{m.Interceptor()},
+	})
+	registry.Register(a.worker)
+	if err := a.worker.Start(); err != nil {
+		return fmt.Errorf("start worker: %w", err)
+	}
+	a.log.WithField("task_queue", a.taskQueue).Info("worker started")
+
+	if a.codecs.ClaimCheck != nil && a.claimCheckTTL > 0 {
+		a.startClaimCheckGC()
+	}
+
+	a.levels = logger.NewLevelController(a.log, a.logLevelTTL)
+	a.stopLevelSignals = a.levels.NotifySignals()
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", m.HTTPHandler())
+	mux.Handle("/loglevel", a.levels)
+	if a.admin, err = serveHTTP(a.log, "admin server", a.adminAddr, mux); err != nil {
+		return err
+	}
+	return nil
+}
+
+// startClaimCheckGC runs the garbage collection of the claim check store until Stop.
+func (a *WorkerApp) startClaimCheckGC() {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		codec.CollectGarbage(ctx, a.codecs.ClaimCheck.Store, a.claimCheckTTL, claimCheckGCInterval, a.log.Named("claim_check"))
+	}()
+	a.stopClaimCheckGC = func() {
+		cancel()
+		<-done
+	}
+}
+
+func (a *WorkerApp) Stop(ctx context.Context) error {
+	var errs []error
+	if a.stopClaimCheckGC != nil {
+		a.stopClaimCheckGC()
+	}
+	if a.levels != nil {
+		a.stopLevelSignals()
+		a.levels.Stop()
+	}
+	if a.worker != nil {
+		a.worker.Stop()
+	}
+	if a.client != nil {
+		a.client.Close()
+	}
+	if a.admin != nil {
+		if err := a.admin.Shutdown(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("shutdown admin server: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -3,15 +3,117 @@ package main
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
 	"os"
 	"os/signal"
 	"time"
+
+	"github.com/nash-567/goTemporalLoom/cmd/gotemporalloom/app"
+	"github.com/nash-567/goTemporalLoom/pkg/logger"
+	logModel "github.com/nash-567/goTemporalLoom/pkg/logger/model"
 )
 
 const gracefulShutDownTimeout = 10 * time.Second
 
+// time given to write the queued log messages before the log file is closed.
+const logFlushTimeout = 5 * time.Second
+
+// environment variable holding the URL error messages are posted to.
+const logWebhookEnv = "LOG_WEBHOOK_URL"
+
+// section of the LOG_CONFIG file holding the log settings.
+const logConfigSection = "logging"
+
+// default rotation of the log file written when LOG_OUTPUT is a file path.
+const (
+	logFileMaxSize    = 100 << 20 // 100 MiB
+	logFileInterval   = 24 * time.Hour
+	logFileMaxBackups = 7
+)
+
+const usage = `usage: gotemporalloom <command> [flags]
+
+commands:
+  worker         run the Temporal worker and serve /metrics
+  codec-server   serve /encode and /decode for the Temporal UI and CLI
+  replay         replay workflow history files or directories to check determinism`
+
 func main() {
+	logConfig, closeOutput, err := newLogConfig()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	// messages logged with a context carry its trace and, in activities, the activity identity
+	logConfig.ContextExtractors = []logModel.ContextExtractor{logger.ExtractTraceContext, logger.ExtractActivityInfo}
+	log, err := logger.NewSlogLoggerE(logConfig)
+	if err != nil {
+		closeOutput()
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	// Error and Fatal messages are also posted to the error tracker at LOG_WEBHOOK_URL, when set, in the
+	// background and once per minute for repeated errors
+	var (
+		webhook *logger.AsyncHook
+		dedup   *logger.DedupHook
+	)
+	if url := os.Getenv(logWebhookEnv); url != "" {
+		webhook = logger.NewAsyncHook(logger.NewWebhookHook(&logModel.WebhookConfig{URL: url}),
+			&logModel.AsyncConfig{Overflow: logModel.OverflowDropNewest})
+		dedup = logger.NewDedupHook(webhook, &logModel.DedupConfig{})
+		log.AddHook(dedup)
+	}
+
+	// Post the counts of repeated errors and the queued errors, write the queued log messages, then flush
+	// and close the log file once the application has stopped.
+	// os.Exit does not run deferred functions, so closeLog is also called before exiting.
+	closeLog := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), logFlushTimeout)
+		defer cancel()
+		if webhook != nil {
+			if err := dedup.Close(); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+			}
+			if err := webhook.Close(ctx); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+			}
+		}
+		if err := log.Close(ctx); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+		closeOutput()
+	}
+	defer closeLog()
+	// Fatal runs the exit hooks last registered first, so the log output is closed after everything else
+	log.RegisterExitHook("close log output", func(context.Context) error {
+		closeLog()
+		return nil
+	})
+
+	// Replay is a one-shot command rather than a long-running application
+	if len(os.Args) > 1 && os.Args[1] == "replay" {
+		if err := app.RunReplay(log, os.Args[2:], os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			closeLog()
+			os.Exit(1)
+		}
+		return
+	}
+
+	// Select the application to run from the subcommand given on the command line
+	application, err := newApp(log, os.Args[1:])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usage)
+		closeLog()
+		os.Exit(2)
+	}
+	log.RegisterExitHook("stop application", application.Stop)
+
 	// Set up a context that will be canceled when an os.Interrupt signal is received (e.g., Ctrl+C)
 	// signal.NotifyContext creates a context that will be canceled when an os.Interrupt signal is caught
 	// This also sets up listeners for the os.Interrupt signal, which need to be cleaned up later
@@ -20,9 +122,13 @@ func main() {
 	// which will clean up the signal notification setup and restore default signal handling behavior
 	defer stop()
 
-	// Initialize and start the origination application with the signal-aware context
-	//originationApp := app.NewOriginationApp()
-	//originationApp.Start(ctx)
+	// Initialize and start the application with the signal-aware context
+	if err := application.Start(ctx); err != nil {
+		log.WithError(err).Error("failed to start application")
+		stop()
+		closeLog()
+		os.Exit(1) //nolint:gocritic // stop and closeLog are called explicitly before exiting
+	}
 
 	// Block the main function until the context is done, which means an interrupt signal was received
 	<-ctx.Done()
@@ -44,12 +150,66 @@ func main() {
 		<-timeoutCtx.Done()
 		if err := timeoutCtx.Err(); errors.Is(err, context.DeadlineExceeded) {
 			// If the graceful shutdown times out, log the error and forcefully exit the application
-			slog.Error("Graceful shutdown timed out, shutting down forcefully", err)
+			slog.Error("Graceful shutdown timed out, shutting down forcefully", "error", err)
+			closeLog()
 			os.Exit(1)
 		}
 	}()
 
-	// Begin the graceful shutdown of the origination application
-	//originationApp.Stop(timeoutCtx)
+	// Begin the graceful shutdown of the application
+	if err := application.Stop(timeoutCtx); err != nil {
+		log.WithError(err).Error("failed to stop application gracefully")
+	}
+}
+
+// newLogConfig returns the log configuration read from the "logging" section of the LOG_CONFIG file, when set,
+// and overridden by the LOG_LEVEL, LOG_FORMAT, LOG_OUTPUT, LOG_SOURCE and LOG_LEVELS environment variables.
+// A log file without rotation settings is rotated daily or at 100 MiB, keeping 7 gzipped backups.
+// The returned function flushes and closes the log file; it can safely be called more than once.
+func newLogConfig() (*logModel.Config, func(), error) {
+	settings := &logModel.Settings{}
+	if path := os.Getenv("LOG_CONFIG"); path != "" {
+		var err error
+		if settings, err = logModel.SettingsFromFile(path, logConfigSection); err != nil {
+			return nil, nil, fmt.Errorf("load log config: %w", err)
+		}
+	}
+	if err := settings.ApplyEnv(os.Getenv); err != nil {
+		return nil, nil, fmt.Errorf("load log config: %w", err)
+	}
+	if settings.Rotation == nil {
+		settings.Rotation = &logModel.RotationSettings{
+			MaxSize:    logFileMaxSize,
+			Interval:   logFileInterval.String(),
+			MaxBackups: logFileMaxBackups,
+			Compress:   true,
+		}
+	}
+
+	config, output, err := logger.NewConfig(settings)
+	if err != nil {
+		return nil, nil, err //nolint:wrapcheck // already describes the invalid settings
+	}
+	return config, func() {
+		if err := output.Close(); err != nil {
+			fmt.Fprintln(os.Stderr, "close log file:", err)
+		}
+	}, nil
+}
 
+// newApp returns the application selected by the first command line argument.
+//
+//nolint:ireturn // the selected app is only known at runtime
+func newApp(log *logger.SlogLogger, args []string) (app.App, error) {
+	if len(args) == 0 {
+		return nil, errors.New("missing command")
+	}
+	switch args[0] {
+	case "worker":
+		return app.NewWorkerApp(log, args[1:])
+	case "codec-server":
+		return app.NewCodecServerApp(log, args[1:])
+	default:
+		return nil, fmt.Errorf("unknown command %q", args[0])
+	}
 }
@@ -0,0 +1,17 @@
+// Command workflowcheck reports non-deterministic code in model-based workflows.
+//
+// It runs standalone on package patterns or as a vet tool:
+//
+//	go run ./cmd/workflowcheck ./...
+//	go build -o bin/workflowcheck ./cmd/workflowcheck && go vet -vettool=$(pwd)/bin/workflowcheck ./...
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/determinism"
+)
+
+func main() {
+	singlechecker.Main(determinism.Analyzer)
+}
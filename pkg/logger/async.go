@@ -0,0 +1,274 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+// AsyncStats are the counters of an AsyncHandler.
+type AsyncStats struct {
+	// Queued is the number of messages waiting to be written.
+	Queued int
+
+	// Written is the number of messages passed to the wrapped handler.
+	Written uint64
+
+	// DroppedNewest and DroppedOldest are the messages dropped by the overflow policy.
+	DroppedNewest uint64
+	DroppedOldest uint64
+
+	// Failed is the number of messages the wrapped handler returned an error for.
+	Failed uint64
+}
+
+// AsyncHandler queues records and passes them to a handler from a background goroutine, so that logging does
+// not wait for a slow output. Records are written in order; when the queue is full, the model.OverflowPolicy
+// blocks the caller or drops a record. Errors of the wrapped handler are counted in AsyncStats.Failed.
+// Handlers derived with WithAttrs and WithGroup share the queue of the handler they derive from.
+//
+// Close drains the queue before returning; records handled after Close are written synchronously, so that
+// messages logged late during shutdown are not lost.
+type AsyncHandler struct {
+	handler slog.Handler
+	queue   *asyncQueue
+}
+
+type asyncRecord struct {
+	handler slog.Handler
+	ctx     context.Context //nolint:containedctx // the record is handled later with the context it was logged with
+	record  slog.Record
+}
+
+// flushWaiter is released once the records queued before it are done.
+type flushWaiter struct {
+	seq  uint64
+	done chan struct{}
+}
+
+type asyncQueue struct {
+	mu       sync.Mutex
+	notEmpty *sync.Cond
+	notFull  *sync.Cond
+	size     int
+	overflow model.OverflowPolicy
+	records  []asyncRecord
+	// queued counts the records ever queued and done the records written or dropped from the queue,
+	// in queue order, so that a flush waits for done to reach the value of queued when it started.
+	queued  uint64
+	done    uint64
+	waiters []flushWaiter
+	stats   AsyncStats
+	closed  bool
+	stopped chan struct{}
+}
+
+// NewAsyncHandler returns a handler writing to handler from a background goroutine, which runs until Close.
+func NewAsyncHandler(handler slog.Handler, config *model.AsyncConfig) *AsyncHandler {
+	queue := &asyncQueue{
+		size:     config.GetQueueSize(),
+		overflow: config.Overflow,
+		stopped:  make(chan struct{}),
+	}
+	queue.notEmpty = sync.NewCond(&queue.mu)
+	queue.notFull = sync.NewCond(&queue.mu)
+	go queue.run()
+	return &AsyncHandler{handler: handler, queue: queue}
+}
+
+func (h *AsyncHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.handler.Enabled(ctx, level)
+}
+
+// Handle queues a copy of record. It only returns the errors of the wrapped handler once the handler is closed.
+//
+//nolint:wrapcheck // errors are passed through unchanged
+func (h *AsyncHandler) Handle(ctx context.Context, record slog.Record) error {
+	item := asyncRecord{handler: h.handler, ctx: context.WithoutCancel(ctx), record: record.Clone()}
+	if !h.queue.push(item) {
+		return h.handler.Handle(ctx, record)
+	}
+	return nil
+}
+
+//nolint:ireturn // implements slog.Handler interface
+func (h *AsyncHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &AsyncHandler{handler: h.handler.WithAttrs(attrs), queue: h.queue}
+}
+
+//nolint:ireturn // implements slog.Handler interface
+func (h *AsyncHandler) WithGroup(name string) slog.Handler {
+	return &AsyncHandler{handler: h.handler.WithGroup(name), queue: h.queue}
+}
+
+// Flush waits until the records queued before the call are written or dropped, or until ctx is done.
+func (h *AsyncHandler) Flush(ctx context.Context) error {
+	q := h.queue
+	q.mu.Lock()
+	if q.done >= q.queued {
+		q.mu.Unlock()
+		return nil
+	}
+	waiter := flushWaiter{seq: q.queued, done: make(chan struct{})}
+	q.waiters = append(q.waiters, waiter)
+	q.mu.Unlock()
+
+	select {
+	case <-waiter.done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("flush log queue: %w", ctx.Err())
+	}
+}
+
+// Close stops queueing records and waits until the queue is drained, or until ctx is done. It can safely be
+// called more than once; the queue keeps draining in the background after ctx is done.
+func (h *AsyncHandler) Close(ctx context.Context) error {
+	q := h.queue
+	q.mu.Lock()
+	q.closed = true
+	q.notEmpty.Broadcast()
+	q.notFull.Broadcast()
+	q.mu.Unlock()
+
+	select {
+	case <-q.stopped:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("close log queue: %w", ctx.Err())
+	}
+}
+
+// Stats returns the current counters of the handler.
+func (h *AsyncHandler) Stats() AsyncStats {
+	h.queue.mu.Lock()
+	defer h.queue.mu.Unlock()
+	stats := h.queue.stats
+	stats.Queued = len(h.queue.records)
+	return stats
+}
+
+// Flush reports the messages dropped by sampling during the current interval, then waits until the messages
+// logged before the call are written when Config.Async is set, or until ctx is done.
+func (log *SlogLogger) Flush(ctx context.Context) error {
+	if err := log.flushSampling(ctx); err != nil {
+		return err
+	}
+	errs := make([]error, 0, len(log.async))
+	for _, async := range log.async {
+		errs = append(errs, async.Flush(ctx))
+	}
+	return errors.Join(errs...)
+}
+
+// Close reports the messages dropped by sampling during the current interval and drains the queue of an
+// asynchronous logger, or gives up when ctx is done. Messages logged after Close are written synchronously.
+// Close does not close the output.
+func (log *SlogLogger) Close(ctx context.Context) error {
+	if err := log.flushSampling(ctx); err != nil {
+		return err
+	}
+	errs := make([]error, 0, len(log.async))
+	for _, async := range log.async {
+		errs = append(errs, async.Close(ctx))
+	}
+	return errors.Join(errs...)
+}
+
+// flushSampling reports the messages dropped by sampling during the current interval.
+func (log *SlogLogger) flushSampling(ctx context.Context) error {
+	if log.sampling == nil {
+		return nil
+	}
+	return log.sampling.state.flush(ctx)
+}
+
+// AsyncStats returns the counters of the queue of an asynchronous logger, and zero for synchronous loggers.
+// With sinks, the counters of their queues are summed: a message written to two sinks counts twice.
+func (log *SlogLogger) AsyncStats() AsyncStats {
+	var stats AsyncStats
+	for _, async := range log.async {
+		sinkStats := async.Stats()
+		stats.Queued += sinkStats.Queued
+		stats.Written += sinkStats.Written
+		stats.DroppedNewest += sinkStats.DroppedNewest
+		stats.DroppedOldest += sinkStats.DroppedOldest
+		stats.Failed += sinkStats.Failed
+	}
+	return stats
+}
+
+// push queues item according to the overflow policy. It returns false when the queue is closed.
+func (q *asyncQueue) push(item asyncRecord) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for !q.closed && len(q.records) >= q.size {
+		switch q.overflow {
+		case model.OverflowDropNewest:
+			q.stats.DroppedNewest++
+			return true
+		case model.OverflowDropOldest:
+			q.records[0] = asyncRecord{}
+			q.records = q.records[1:]
+			q.stats.DroppedOldest++
+			q.finishLocked()
+		default:
+			q.notFull.Wait()
+		}
+	}
+	if q.closed {
+		return false
+	}
+	q.records = append(q.records, item)
+	q.queued++
+	q.notEmpty.Signal()
+	return true
+}
+
+// run writes the queued records until the queue is closed and drained.
+func (q *asyncQueue) run() {
+	defer close(q.stopped)
+	for {
+		q.mu.Lock()
+		for len(q.records) == 0 && !q.closed {
+			q.notEmpty.Wait()
+		}
+		if len(q.records) == 0 {
+			q.mu.Unlock()
+			return
+		}
+		item := q.records[0]
+		q.records[0] = asyncRecord{}
+		q.records = q.records[1:]
+		q.notFull.Broadcast()
+		q.mu.Unlock()
+
+		err := item.handler.Handle(item.ctx, item.record)
+
+		q.mu.Lock()
+		q.stats.Written++
+		if err != nil {
+			q.stats.Failed++
+		}
+		q.finishLocked()
+		q.mu.Unlock()
+	}
+}
+
+// finishLocked counts a record out of the queue and releases the flushes waiting for it.
+func (q *asyncQueue) finishLocked() {
+	q.done++
+	waiters := q.waiters[:0]
+	for _, waiter := range q.waiters {
+		if waiter.seq <= q.done {
+			close(waiter.done)
+			continue
+		}
+		waiters = append(waiters, waiter)
+	}
+	q.waiters = waiters
+}
@@ -0,0 +1,175 @@
+package logger_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger"
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+// gatedWriter holds writes until release is closed, like a stdout pipe nobody reads.
+type gatedWriter struct {
+	started  chan struct{}
+	release  chan struct{}
+	mu       sync.Mutex
+	messages []string
+}
+
+func newGatedWriter() *gatedWriter {
+	return &gatedWriter{started: make(chan struct{}, 100), release: make(chan struct{})}
+}
+
+func (w *gatedWriter) Write(p []byte) (int, error) {
+	select {
+	case w.started <- struct{}{}:
+	default:
+	}
+	<-w.release
+
+	var entry struct {
+		Msg string `json:"msg"`
+	}
+	if err := json.Unmarshal(p, &entry); err != nil {
+		return 0, fmt.Errorf("decode entry: %w", err)
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.messages = append(w.messages, entry.Msg)
+	return len(p), nil
+}
+
+func (w *gatedWriter) Messages() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]string(nil), w.messages...)
+}
+
+func TestSlogLogger_Async(t *testing.T) {
+	t.Parallel()
+	output := newGatedWriter()
+	close(output.release)
+	log := logger.NewSlogLogger(&model.Config{Output: output, Async: &model.AsyncConfig{QueueSize: 8}})
+
+	want := make([]string, 0, 100)
+	for i := range 100 {
+		want = append(want, fmt.Sprintf("message %d", i))
+		log.WithField("i", i).Info(want[i])
+	}
+	require.NoError(t, log.Flush(context.Background()))
+	assert.Equal(t, want, output.Messages())
+	assert.Equal(t, logger.AsyncStats{Written: 100}, log.Named("worker").AsyncStats())
+
+	require.NoError(t, log.Close(context.Background()))
+	require.NoError(t, log.Close(context.Background()))
+	log.Info("after close")
+	assert.Equal(t, "after close", output.Messages()[100], "messages logged after Close are written synchronously")
+}
+
+func TestAsyncHandler_Overflow(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		overflow  model.OverflowPolicy
+		want      []string
+		wantStats logger.AsyncStats
+	}{
+		{
+			name:      "drop newest",
+			overflow:  model.OverflowDropNewest,
+			want:      []string{"1", "2", "3"},
+			wantStats: logger.AsyncStats{Written: 3, DroppedNewest: 2},
+		},
+		{
+			name:      "drop oldest",
+			overflow:  model.OverflowDropOldest,
+			want:      []string{"1", "4", "5"},
+			wantStats: logger.AsyncStats{Written: 3, DroppedOldest: 2},
+		},
+		{
+			name:      "block",
+			overflow:  model.OverflowBlock,
+			want:      []string{"1", "2", "3", "4", "5"},
+			wantStats: logger.AsyncStats{Written: 5},
+		},
+	}
+	for _, tC := range tests {
+		tt := tC
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			output := newGatedWriter()
+			handler := logger.NewAsyncHandler(slog.NewJSONHandler(output, nil), &model.AsyncConfig{
+				QueueSize: 2,
+				Overflow:  tt.overflow,
+			})
+			log := slog.New(handler)
+
+			// the first message is being written while the next ones fill the queue
+			log.Info("1")
+			<-output.started
+			log.Info("2")
+			log.Info("3")
+			assert.Equal(t, 2, handler.Stats().Queued)
+
+			overflowed := make(chan struct{})
+			go func() {
+				defer close(overflowed)
+				log.Info("4")
+				log.Info("5")
+			}()
+			if tt.overflow == model.OverflowBlock {
+				select {
+				case <-overflowed:
+					t.Fatal("logging to a full queue did not block")
+				case <-time.After(20 * time.Millisecond):
+				}
+				close(output.release)
+				<-overflowed
+			} else {
+				<-overflowed
+				close(output.release)
+			}
+
+			require.NoError(t, handler.Close(context.Background()))
+			assert.Equal(t, tt.want, output.Messages())
+			assert.Equal(t, tt.wantStats, handler.Stats())
+		})
+	}
+}
+
+func TestAsyncHandler_FlushTimeout(t *testing.T) {
+	t.Parallel()
+	output := newGatedWriter()
+	handler := logger.NewAsyncHandler(slog.NewJSONHandler(output, nil), &model.AsyncConfig{})
+	slog.New(handler).Info("stuck")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, handler.Flush(ctx), context.DeadlineExceeded)
+	require.ErrorIs(t, handler.Close(ctx), context.DeadlineExceeded)
+
+	close(output.release)
+	require.NoError(t, handler.Close(context.Background()))
+	assert.Equal(t, []string{"stuck"}, output.Messages())
+}
+
+func TestAsyncHandler_Failed(t *testing.T) {
+	t.Parallel()
+	handler := logger.NewAsyncHandler(slog.NewJSONHandler(failingWriter{}, nil), &model.AsyncConfig{})
+	log := slog.New(handler).With("order", 42).WithGroup("payment")
+	log.Error("charge failed")
+	log.Error("refund failed")
+
+	require.NoError(t, handler.Flush(context.Background()))
+	assert.Equal(t, logger.AsyncStats{Written: 2, Failed: 2}, handler.Stats())
+	require.NoError(t, handler.Close(context.Background()))
+	require.ErrorIs(t, handler.Handle(context.Background(), slog.NewRecord(time.Now(), slog.LevelInfo, "late", 0)), errWrite)
+}
@@ -0,0 +1,112 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+// key of the attribute holding the name of a named logger.
+const loggerKey = "logger"
+
+// Named returns a logger for component, logging a "logger" attribute with its dotted name: Named("temporal")
+// on a logger named "orchestrator" is named "orchestrator.temporal". The level of a named logger is the level
+// configured for its name or the closest of its parents, and can be changed with SetLevel.
+func (log *SlogLogger) Named(component string) *SlogLogger {
+	name := component
+	if log.name != "" {
+		name = log.name + "." + component
+	}
+	return log.derive(log.handler, name)
+}
+
+// componentLevels holds the level of the root logger and the levels overridden for named loggers.
+// It is shared by a logger and all the loggers derived from it.
+type componentLevels struct {
+	root      *slog.LevelVar
+	mu        sync.RWMutex
+	overrides map[string]slog.Level
+}
+
+func newComponentLevels(config *model.Config) *componentLevels {
+	levels := &componentLevels{
+		root:      new(slog.LevelVar),
+		overrides: make(map[string]slog.Level, len(config.ComponentLevels)),
+	}
+	levels.root.Set(config.GetSlogLevel())
+	for name, level := range config.ComponentLevels {
+		levels.overrides[name] = model.ParseLevel(level).SlogLevel()
+	}
+	return levels
+}
+
+// Level is the lowest level enabled for any logger, used as the level of the handlers they share.
+func (l *componentLevels) Level() slog.Level {
+	level := l.root.Level()
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	for _, override := range l.overrides {
+		level = min(level, override)
+	}
+	return level
+}
+
+// level returns the level for name, looking up its parents when it has no override.
+func (l *componentLevels) level(name string) slog.Level {
+	if name == "" {
+		return l.root.Level()
+	}
+
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	for {
+		if level, ok := l.overrides[name]; ok {
+			return level
+		}
+		i := strings.LastIndexByte(name, '.')
+		if i < 0 {
+			return l.root.Level()
+		}
+		name = name[:i]
+	}
+}
+
+func (l *componentLevels) set(name string, level slog.Level) {
+	if name == "" {
+		l.root.Set(level)
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.overrides[name] = level
+}
+
+// componentHandler filters records by the level of a named logger.
+type componentHandler struct {
+	handler slog.Handler
+	name    string
+	levels  *componentLevels
+}
+
+func (h *componentHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= h.levels.level(h.name) && h.handler.Enabled(ctx, level)
+}
+
+//nolint:wrapcheck // errors are passed through unchanged
+func (h *componentHandler) Handle(ctx context.Context, record slog.Record) error {
+	return h.handler.Handle(ctx, record)
+}
+
+//nolint:ireturn // implements slog.Handler interface
+func (h *componentHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &componentHandler{handler: h.handler.WithAttrs(attrs), name: h.name, levels: h.levels}
+}
+
+//nolint:ireturn // implements slog.Handler interface
+func (h *componentHandler) WithGroup(name string) slog.Handler {
+	return &componentHandler{handler: h.handler.WithGroup(name), name: h.name, levels: h.levels}
+}
@@ -0,0 +1,101 @@
+package logger_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger"
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+func newComponentLogger() (*logger.SlogLogger, *strings.Builder) {
+	output := new(strings.Builder)
+	return logger.NewSlogLogger(&model.Config{
+		Output: output,
+		Level:  "info",
+		ComponentLevels: map[string]string{
+			"orchestrator.temporal":     "debug",
+			"orchestrator.temporal.sdk": "warn",
+		},
+	}), output
+}
+
+func TestSlogLogger_Named(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		component []string
+		wantLevel model.Level
+		wantName  string
+	}{
+		{name: "root", wantLevel: model.InfoLevel},
+		{name: "no override", component: []string{"payments"}, wantLevel: model.InfoLevel, wantName: "payments"},
+		{
+			name:      "override",
+			component: []string{"orchestrator", "temporal"},
+			wantLevel: model.DebugLevel,
+			wantName:  "orchestrator.temporal",
+		},
+		{
+			name:      "inherited override",
+			component: []string{"orchestrator", "temporal", "activity"},
+			wantLevel: model.DebugLevel,
+			wantName:  "orchestrator.temporal.activity",
+		},
+		{
+			name:      "nested override",
+			component: []string{"orchestrator", "temporal", "sdk"},
+			wantLevel: model.WarnLevel,
+			wantName:  "orchestrator.temporal.sdk",
+		},
+	}
+	for _, tC := range tests {
+		tt := tC
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			log, output := newComponentLogger()
+			for _, component := range tt.component {
+				log = log.Named(component)
+			}
+
+			assert.Equal(t, tt.wantLevel, log.GetLevel())
+			log.Debug("debug message")
+			log.WithField("order", 42).Warn("warn message")
+
+			assert.Equal(t, tt.wantLevel == model.DebugLevel, strings.Contains(output.String(), "debug message"))
+			assert.Contains(t, output.String(), `"order":42`)
+			if tt.wantName == "" {
+				assert.NotContains(t, output.String(), `"logger"`)
+				return
+			}
+			assert.Equal(t, strings.Count(output.String(), "\n"), strings.Count(output.String(), `"logger":"`+tt.wantName+`"`))
+		})
+	}
+}
+
+func TestSlogLogger_NamedSetLevel(t *testing.T) {
+	t.Parallel()
+	log, output := newComponentLogger()
+	payments := log.Named("payments")
+	sdk := log.Named("orchestrator").Named("temporal").Named("sdk")
+
+	payments.SetLevel(model.DebugLevel)
+	assert.Equal(t, model.DebugLevel, payments.GetLevel())
+	assert.Equal(t, model.DebugLevel, payments.Named("charge").GetLevel())
+	assert.Equal(t, model.InfoLevel, log.GetLevel())
+	assert.Equal(t, model.WarnLevel, sdk.GetLevel())
+
+	payments.WithField("order", 42).Debug("charging")
+	log.Debug("root debug")
+	sdk.Info("sdk info")
+	assert.Contains(t, output.String(), `"msg":"charging","order":42,"logger":"payments"`)
+	assert.NotContains(t, output.String(), "root debug")
+	assert.NotContains(t, output.String(), "sdk info")
+
+	// the root level applies to loggers without override
+	log.SetLevel(model.ErrorLevel)
+	assert.Equal(t, model.ErrorLevel, log.Named("other").GetLevel())
+	assert.Equal(t, model.DebugLevel, payments.GetLevel())
+}
@@ -0,0 +1,303 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+const (
+	consoleTimeFormat = "15:04:05.000"
+	// width of the longest level label, used to align messages.
+	consoleLevelWidth = 5
+	// width messages are padded to, so that the attributes of consecutive lines are aligned.
+	consoleMessageWidth = 40
+
+	ansiReset   = "\033[0m"
+	ansiFaint   = "\033[2m"
+	ansiRed     = "\033[31m"
+	ansiGreen   = "\033[32m"
+	ansiYellow  = "\033[33m"
+	ansiBlue    = "\033[34m"
+	ansiMagenta = "\033[35;1m"
+	ansiCyan    = "\033[36m"
+)
+
+// consoleHandler is a slog.Handler writing colored, human-friendly lines:
+//
+//	15:04:05.000 INFO  charge failed                            order.id=o-1 order.items.0=book error=declined
+//	15:04:05.000 INFO  charged                                  order.id=o-2
+//
+// Messages are padded so that attributes start in the same column, and composite values such as structs
+// and maps are flattened into key=value pairs with dotted keys. Attributes go through
+// HandlerOptions.ReplaceAttr exactly like with the JSON and text handlers.
+type consoleHandler struct {
+	opts   slog.HandlerOptions
+	out    io.Writer
+	mu     *sync.Mutex
+	color  bool
+	attrs  []byte
+	groups []string
+}
+
+func newConsoleHandler(out io.Writer, opts *slog.HandlerOptions, color bool) *consoleHandler {
+	return &consoleHandler{
+		opts:  *opts,
+		out:   out,
+		mu:    &sync.Mutex{},
+		color: color,
+	}
+}
+
+func (h *consoleHandler) Enabled(_ context.Context, level slog.Level) bool {
+	minLevel := slog.LevelInfo
+	if h.opts.Level != nil {
+		minLevel = h.opts.Level.Level()
+	}
+	return level >= minLevel
+}
+
+func (h *consoleHandler) Handle(_ context.Context, record slog.Record) error {
+	buf := make([]byte, 0, 256) //nolint:mnd // initial buffer size
+
+	if !record.Time.IsZero() {
+		if a, ok := h.replace(slog.Time(slog.TimeKey, record.Time)); ok {
+			value := a.Value.String()
+			if a.Value.Kind() == slog.KindTime {
+				value = a.Value.Time().Format(consoleTimeFormat)
+			}
+			buf = h.appendColored(buf, ansiFaint, value)
+			buf = append(buf, ' ')
+		}
+	}
+
+	if a, ok := h.replace(slog.Any(slog.LevelKey, record.Level)); ok {
+		label := a.Value.String()
+		buf = h.appendColored(buf, levelColor(record.Level), label)
+		buf = append(buf, strings.Repeat(" ", max(consoleLevelWidth-len(label), 0)+1)...)
+	}
+
+	if h.opts.AddSource && record.PC != 0 {
+		frame, _ := runtime.CallersFrames([]uintptr{record.PC}).Next()
+		source := &slog.Source{Function: frame.Function, File: frame.File, Line: frame.Line}
+		if a, ok := h.replace(slog.Any(slog.SourceKey, source)); ok {
+			value := a.Value.String()
+			if s, isSource := a.Value.Any().(*slog.Source); isSource {
+				value = filepath.Base(filepath.Dir(s.File)) + "/" + filepath.Base(s.File) + ":" + strconv.Itoa(s.Line)
+			}
+			buf = h.appendColored(buf, ansiFaint, value)
+			buf = append(buf, ' ')
+		}
+	}
+
+	attrs := append([]byte(nil), h.attrs...)
+	record.Attrs(func(a slog.Attr) bool {
+		attrs = h.appendAttr(attrs, h.groups, a)
+		return true
+	})
+
+	if a, ok := h.replace(slog.String(slog.MessageKey, record.Message)); ok {
+		msg := a.Value.String()
+		buf = append(buf, msg...)
+		if len(attrs) > 0 {
+			buf = append(buf, strings.Repeat(" ", max(consoleMessageWidth-utf8.RuneCountInString(msg), 0))...)
+		}
+	}
+	buf = append(buf, attrs...)
+	buf = append(buf, '\n')
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, err := h.out.Write(buf); err != nil {
+		return fmt.Errorf("write log entry: %w", err)
+	}
+	return nil
+}
+
+//nolint:ireturn // implements slog.Handler interface
+func (h *consoleHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clone := *h
+	clone.attrs = append([]byte(nil), h.attrs...)
+	for _, a := range attrs {
+		clone.attrs = h.appendAttr(clone.attrs, h.groups, a)
+	}
+	return &clone
+}
+
+//nolint:ireturn // implements slog.Handler interface
+func (h *consoleHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	clone := *h
+	clone.groups = append(append([]string(nil), h.groups...), name)
+	return &clone
+}
+
+// replace applies ReplaceAttr to a built-in attribute and reports whether it should be written.
+func (h *consoleHandler) replace(a slog.Attr) (slog.Attr, bool) {
+	if h.opts.ReplaceAttr != nil {
+		a = h.opts.ReplaceAttr(nil, a)
+	}
+	return a, a.Key != ""
+}
+
+func (h *consoleHandler) appendAttr(buf []byte, groups []string, a slog.Attr) []byte {
+	a.Value = a.Value.Resolve()
+	if a.Value.Kind() != slog.KindGroup && h.opts.ReplaceAttr != nil {
+		a = h.opts.ReplaceAttr(groups, a)
+		a.Value = a.Value.Resolve()
+	}
+	if a.Equal(slog.Attr{}) {
+		return buf
+	}
+
+	if a.Value.Kind() == slog.KindGroup {
+		if a.Key != "" {
+			groups = append(append([]string(nil), groups...), a.Key)
+		}
+		for _, ga := range a.Value.Group() {
+			buf = h.appendAttr(buf, groups, ga)
+		}
+		return buf
+	}
+
+	key := a.Key
+	if len(groups) > 0 {
+		key = strings.Join(groups, ".") + "." + key
+	}
+	keyColor := ansiCyan
+	if a.Key == errorKey {
+		keyColor = ansiRed
+	}
+	for _, pair := range consolePairs(key, a.Value) {
+		buf = append(buf, ' ')
+		buf = h.appendColored(buf, keyColor, pair.key+"=")
+		buf = append(buf, pair.value...)
+	}
+	return buf
+}
+
+func (h *consoleHandler) appendColored(buf []byte, color, s string) []byte {
+	if !h.color {
+		return append(buf, s...)
+	}
+	buf = append(buf, color...)
+	buf = append(buf, s...)
+	return append(buf, ansiReset...)
+}
+
+func levelColor(level slog.Level) string {
+	switch {
+	case level >= model.LevelFatal:
+		return ansiMagenta
+	case level >= slog.LevelError:
+		return ansiRed
+	case level >= slog.LevelWarn:
+		return ansiYellow
+	case level >= slog.LevelInfo:
+		return ansiGreen
+	default:
+		return ansiBlue
+	}
+}
+
+type consolePair struct {
+	key, value string
+}
+
+// consolePairs returns the key=value pairs of an attribute. Composite values are encoded as by the JSON
+// handler and flattened: object fields and array elements get the key of their parent and their name or
+// index, joined with dots.
+func consolePairs(key string, v slog.Value) []consolePair {
+	if v.Kind() == slog.KindAny {
+		switch v.Any().(type) {
+		case error, fmt.Stringer:
+		default:
+			if data, err := json.Marshal(v.Any()); err == nil {
+				decoder := json.NewDecoder(bytes.NewReader(data))
+				decoder.UseNumber()
+				var decoded interface{}
+				if err := decoder.Decode(&decoded); err == nil {
+					return flattenConsoleValue(nil, key, decoded)
+				}
+			}
+		}
+	}
+	return []consolePair{{key: key, value: formatConsoleValue(v)}}
+}
+
+func flattenConsoleValue(pairs []consolePair, key string, v interface{}) []consolePair {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		if len(value) == 0 {
+			return append(pairs, consolePair{key: key, value: "{}"})
+		}
+		keys := make([]string, 0, len(value))
+		for k := range value {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			pairs = flattenConsoleValue(pairs, key+"."+k, value[k])
+		}
+		return pairs
+	case []interface{}:
+		if len(value) == 0 {
+			return append(pairs, consolePair{key: key, value: "[]"})
+		}
+		for i, element := range value {
+			pairs = flattenConsoleValue(pairs, key+"."+strconv.Itoa(i), element)
+		}
+		return pairs
+	case string:
+		return append(pairs, consolePair{key: key, value: quoteIfNeeded(value)})
+	case nil:
+		return append(pairs, consolePair{key: key, value: "null"})
+	default:
+		return append(pairs, consolePair{key: key, value: fmt.Sprint(value)})
+	}
+}
+
+// formatConsoleValue quotes strings only when needed and formats values that are not flattened.
+func formatConsoleValue(v slog.Value) string {
+	switch v.Kind() {
+	case slog.KindString:
+		return quoteIfNeeded(v.String())
+	case slog.KindTime:
+		return v.Time().Format(time.RFC3339Nano)
+	case slog.KindAny:
+		switch value := v.Any().(type) {
+		case error:
+			return quoteIfNeeded(value.Error())
+		case fmt.Stringer:
+			return quoteIfNeeded(value.String())
+		}
+		return quoteIfNeeded(fmt.Sprintf("%+v", v.Any()))
+	default:
+		return v.String()
+	}
+}
+
+func quoteIfNeeded(s string) string {
+	if s == "" || strings.ContainsFunc(s, func(r rune) bool {
+		return unicode.IsSpace(r) || r == '"' || r == '=' || !unicode.IsPrint(r)
+	}) {
+		return strconv.Quote(s)
+	}
+	return s
+}
@@ -0,0 +1,89 @@
+package logger_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger"
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+var ansiEscape = regexp.MustCompile("\033\\[[0-9;]*m")
+
+func TestSlogLogger_Formats(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{format: "json", want: `{"time":".+","level":"WARN","msg":"This is a test","key":"demo"}`},
+		{format: "text", want: `time=.+ level=WARN msg="This is a test" key=demo`},
+		{format: "console", want: `\d\d:\d\d:\d\d\.\d{3} WARN  This is a test {27}key=demo`},
+		{
+			format: "unknown",
+			want: `{"time":".+","level":"WARN","msg":"invalid logger config, falling back to defaults","error":"format: .+"}\n` +
+				`{"time":".+","level":"WARN","msg":"This is a test","key":"demo"}`,
+		},
+	}
+	for _, tC := range tests {
+		tt := tC
+		t.Run(tt.format, func(t *testing.T) {
+			t.Parallel()
+			output := new(strings.Builder)
+			log := logger.NewSlogLogger(&model.Config{Output: output, Format: tt.format})
+
+			log.WithField("key", "demo").Warn(testMsgText)
+			assert.Regexp(t, "^"+tt.want+"\n$", ansiEscape.ReplaceAllString(output.String(), ""))
+		})
+	}
+}
+
+func TestConsoleHandler(t *testing.T) {
+	t.Parallel()
+	output := new(strings.Builder)
+	log := logger.NewSlogLogger(&model.Config{Output: output, Format: "console", Level: "debug"})
+	entry, ok := log.ToKeyValLogger().(*slog.Logger)
+	require.True(t, ok)
+
+	type item struct {
+		SKU      string `json:"sku"`
+		Quantity int    `json:"quantity"`
+	}
+
+	log.WithError(errLogger).Error("failed")
+	entry.Log(context.Background(), model.LevelFatal, "fatal")
+	entry.Debug("debug")
+	entry.WithGroup("req").Info("grouped",
+		"path", "/a b",
+		"body", map[string]int{"n": 1},
+		slog.Group("user", "id", 7),
+	)
+	log.WithField("order", struct {
+		ID    string   `json:"id"`
+		Items []item   `json:"items"`
+		Tags  []string `json:"tags"`
+		Note  *string  `json:"note"`
+	}{ID: "o-1", Items: []item{{SKU: "book", Quantity: 2}}, Tags: []string{}}).Info("order received")
+
+	lines := strings.Split(strings.TrimSuffix(ansiEscape.ReplaceAllString(output.String(), ""), "\n"), "\n")
+	require.Len(t, lines, 5)
+	assert.Regexp(t, `^\S+ ERROR failed +error="logger error"$`, lines[0])
+	assert.Regexp(t, `^\S+ FATAL fatal$`, lines[1])
+	assert.Regexp(t, `^\S+ DEBUG debug$`, lines[2])
+	assert.Regexp(t, `^\S+ INFO  grouped +req.path="/a b" req.body.n=1 req.user.id=7$`, lines[3])
+	assert.Regexp(t, `^\S+ INFO  order received +order.id=o-1 order.items.0.quantity=2 order.items.0.sku=book `+
+		`order.note=null order.tags=\[\]$`, lines[4])
+	// attributes start in the same column whatever the length of the message
+	assert.Equal(t, strings.Index(lines[0], "error="), strings.Index(lines[3], "req.path="))
+	assert.Equal(t, strings.Index(lines[0], "error="), strings.Index(lines[4], "order.id="))
+	if os.Getenv("NO_COLOR") == "" {
+		assert.Contains(t, output.String(), "\033[31mERROR\033[0m")
+	}
+}
@@ -2,6 +2,10 @@ package logger
 
 import (
 	"context"
+	"fmt"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
 )
@@ -22,3 +26,72 @@ func FromContext(ctx context.Context) model.Logger {
 	}
 	return logger
 }
+
+// contextHandler adds the attributes extracted from the context of each record.
+type contextHandler struct {
+	handler    slog.Handler
+	extractors []model.ContextExtractor
+}
+
+// NewContextHandler returns a slog.Handler adding the attributes returned by extractors for the
+// context of each record before passing it to handler. Like attributes of the record, they are
+// nested in the groups opened with WithGroup.
+//
+//nolint:ireturn // returns the slog.Handler interface
+func NewContextHandler(handler slog.Handler, extractors ...model.ContextExtractor) slog.Handler {
+	return &contextHandler{handler: handler, extractors: extractors}
+}
+
+func (h *contextHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.handler.Enabled(ctx, level)
+}
+
+func (h *contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	var attrs []slog.Attr
+	for _, extract := range h.extractors {
+		attrs = append(attrs, extract(ctx)...)
+	}
+	if len(attrs) > 0 {
+		record = record.Clone()
+		record.AddAttrs(attrs...)
+	}
+	if err := h.handler.Handle(ctx, record); err != nil {
+		return fmt.Errorf("handle record: %w", err)
+	}
+	return nil
+}
+
+//nolint:ireturn // implements slog.Handler interface
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{handler: h.handler.WithAttrs(attrs), extractors: h.extractors}
+}
+
+//nolint:ireturn // implements slog.Handler interface
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{handler: h.handler.WithGroup(name), extractors: h.extractors}
+}
+
+// ExtractTraceContext is a model.ContextExtractor returning the trace_id and span_id of the
+// OpenTelemetry span of the context.
+func ExtractTraceContext(ctx context.Context) []slog.Attr {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return nil
+	}
+	return []slog.Attr{
+		slog.String("trace_id", spanContext.TraceID().String()),
+		slog.String("span_id", spanContext.SpanID().String()),
+	}
+}
+
+// ExtractContextValue returns a model.ContextExtractor logging the value stored in the context
+// under key, e.g. a tenant or request ID, as an attribute named name.
+func ExtractContextValue(name string, key interface{}) model.ContextExtractor {
+	return func(ctx context.Context) []slog.Attr {
+		value := ctx.Value(key)
+		if value == nil {
+			return nil
+		}
+		return []slog.Attr{slog.Any(name, value)}
+	}
+}
@@ -2,9 +2,13 @@ package logger_test
 
 import (
 	"context"
+	"log/slog"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/nash-567/goTemporalLoom/pkg/logger"
 	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
@@ -62,3 +66,91 @@ func TestNewContextWithLogger(t *testing.T) {
 	assert.NotNil(t, got)
 	assert.Same(t, got, log)
 }
+
+type tenantKey struct{}
+
+func newContextLogger() (*logger.SlogLogger, *strings.Builder) {
+	output := new(strings.Builder)
+	return logger.NewSlogLogger(&model.Config{
+		Output: output,
+		Level:  "DEBUG",
+		ContextExtractors: []model.ContextExtractor{
+			logger.ExtractTraceContext,
+			logger.ExtractContextValue("tenant_id", tenantKey{}),
+		},
+	}), output
+}
+
+func TestSlogLogger_ContextExtractors(t *testing.T) {
+	t.Parallel()
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{0x0a, 0x0b},
+		SpanID:  trace.SpanID{0x0c},
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), spanContext)
+	ctx = context.WithValue(ctx, tenantKey{}, "acme")
+
+	tests := []struct {
+		name    string
+		log     func(log *logger.SlogLogger)
+		want    []string
+		notWant []string
+	}{
+		{
+			name: "context attributes",
+			log:  func(log *logger.SlogLogger) { log.WithField("order", 42).InfoCtx(ctx, testMsgText) },
+			want: []string{
+				`"level":"INFO"`, `"order":42`, `"tenant_id":"acme"`,
+				`"trace_id":"0a0b0000000000000000000000000000"`, `"span_id":"0c00000000000000"`,
+			},
+		},
+		{
+			name: "levels",
+			log: func(log *logger.SlogLogger) {
+				log.DebugCtx(ctx, testMsgText)
+				log.WarnCtx(ctx, testMsgText)
+				log.ErrorCtx(ctx, testMsgText)
+			},
+			want:    []string{`"level":"DEBUG"`, `"level":"WARN"`, `"level":"ERROR"`},
+			notWant: []string{`"level":"INFO"`},
+		},
+		{
+			name:    "empty context",
+			log:     func(log *logger.SlogLogger) { log.InfoCtx(context.Background(), testMsgText) },
+			notWant: []string{"tenant_id", "trace_id"},
+		},
+		{
+			name:    "no context",
+			log:     func(log *logger.SlogLogger) { log.Info(testMsgText) },
+			notWant: []string{"tenant_id", "trace_id"},
+		},
+	}
+	for _, tC := range tests {
+		tt := tC
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			log, output := newContextLogger()
+			tt.log(log)
+
+			assert.Contains(t, output.String(), testMsgText)
+			for _, want := range tt.want {
+				assert.Contains(t, output.String(), want)
+			}
+			for _, notWant := range tt.notWant {
+				assert.NotContains(t, output.String(), notWant)
+			}
+		})
+	}
+}
+
+func TestContextHandler_WithGroup(t *testing.T) {
+	t.Parallel()
+	log, output := newContextLogger()
+	entry, ok := log.ToKeyValLogger().(*slog.Logger)
+	require.True(t, ok)
+
+	ctx := context.WithValue(context.Background(), tenantKey{}, "acme")
+	entry.With("service", "billing").WithGroup("request").InfoContext(ctx, testMsgText, "path", "/charge")
+
+	assert.Contains(t, output.String(), `"service":"billing","request":{"path":"/charge","tenant_id":"acme"}`)
+}
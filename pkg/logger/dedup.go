@@ -0,0 +1,161 @@
+package logger
+
+import (
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+// key of the attribute holding the number of records grouped with the previous one.
+const dedupDuplicatesKey = "duplicates"
+
+// DedupHook groups identical records, with the same level, message and error, within a window: the first
+// record of a window is fired and the next ones are counted. The count is added as a "duplicates" attribute
+// to the first record of the next window, or, when no identical record follows, to the last duplicate fired
+// once the window expires or on Close. An error tracker thus receives one event per window for an error
+// repeated by every retry of an activity. Windows are measured with the time of the records.
+type DedupHook struct {
+	hook    Hook
+	window  time.Duration
+	mu      sync.Mutex
+	entries map[dedupKey]*dedupEntry
+	closed  bool
+}
+
+var _ Hook = (*DedupHook)(nil)
+
+type dedupKey struct {
+	level   slog.Level
+	message string
+	err     string
+}
+
+type dedupEntry struct {
+	start      time.Time
+	duplicates int
+	// last is the last duplicate, fired with the count when the window expires.
+	last  slog.Record
+	timer *time.Timer
+}
+
+// summary returns the last duplicate with the number of duplicates and stops the timer of the window.
+func (e *dedupEntry) summary() slog.Record {
+	if e.timer != nil {
+		e.timer.Stop()
+	}
+	record := e.last.Clone()
+	record.AddAttrs(slog.Int(dedupDuplicatesKey, e.duplicates))
+	return record
+}
+
+// NewDedupHook returns a hook grouping the identical records passed to hook.
+func NewDedupHook(hook Hook, config *model.DedupConfig) *DedupHook {
+	return &DedupHook{hook: hook, window: config.GetWindow(), entries: make(map[dedupKey]*dedupEntry)}
+}
+
+func (h *DedupHook) Levels() []model.Level {
+	return h.hook.Levels()
+}
+
+// Fire fires record unless an identical record was fired within the window. Once the hook is closed,
+// records are fired without grouping.
+func (h *DedupHook) Fire(record slog.Record) error {
+	key := dedupKey{level: record.Level, message: record.Message}
+	record.Attrs(func(a slog.Attr) bool {
+		if a.Key == errorKey {
+			key.err = a.Value.String()
+			return false
+		}
+		return true
+	})
+
+	h.mu.Lock()
+	if h.closed {
+		h.mu.Unlock()
+		return h.hook.Fire(record)
+	}
+	entry, ok := h.entries[key]
+	if ok && record.Time.Sub(entry.start) < h.window {
+		entry.duplicates++
+		entry.last = record.Clone()
+		if entry.timer == nil {
+			entry.timer = time.AfterFunc(entry.start.Add(h.window).Sub(record.Time), func() { h.expire(key, entry) })
+		}
+		h.mu.Unlock()
+		return nil
+	}
+	if ok {
+		delete(h.entries, key)
+		if entry.timer != nil {
+			entry.timer.Stop()
+		}
+	}
+	summaries := h.prune(record.Time)
+	h.entries[key] = &dedupEntry{start: record.Time}
+	h.mu.Unlock()
+
+	errs := make([]error, 0, len(summaries)+1)
+	for _, summary := range summaries {
+		errs = append(errs, h.hook.Fire(summary))
+	}
+	if ok && entry.duplicates > 0 {
+		record = record.Clone()
+		record.AddAttrs(slog.Int(dedupDuplicatesKey, entry.duplicates))
+	}
+	errs = append(errs, h.hook.Fire(record))
+	return errors.Join(errs...)
+}
+
+// Close fires the duplicates counted in the current windows and stops their timers.
+func (h *DedupHook) Close() error {
+	h.mu.Lock()
+	h.closed = true
+	summaries := make([]slog.Record, 0, len(h.entries))
+	for key, entry := range h.entries {
+		if entry.duplicates > 0 {
+			summaries = append(summaries, entry.summary())
+		}
+		delete(h.entries, key)
+	}
+	h.mu.Unlock()
+
+	errs := make([]error, 0, len(summaries))
+	for _, summary := range summaries {
+		errs = append(errs, h.hook.Fire(summary))
+	}
+	return errors.Join(errs...)
+}
+
+// expire fires the duplicates of entry once its window has expired, unless an identical record or Close
+// reported them first.
+func (h *DedupHook) expire(key dedupKey, entry *dedupEntry) {
+	h.mu.Lock()
+	if h.entries[key] != entry {
+		h.mu.Unlock()
+		return
+	}
+	delete(h.entries, key)
+	summary := entry.summary()
+	h.mu.Unlock()
+
+	// the error is reported by fireHook as there is no caller to return it to
+	_ = fireHook(h.hook, summary)
+}
+
+// prune drops the windows that ended before now and returns the duplicates they counted, to be fired.
+func (h *DedupHook) prune(now time.Time) []slog.Record {
+	var summaries []slog.Record
+	for key, entry := range h.entries {
+		if now.Sub(entry.start) < h.window {
+			continue
+		}
+		if entry.duplicates > 0 {
+			summaries = append(summaries, entry.summary())
+		}
+		delete(h.entries, key)
+	}
+	return summaries
+}
@@ -0,0 +1,117 @@
+package logger_test
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger"
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+func TestDedupHook(t *testing.T) {
+	t.Parallel()
+	hook := &recordingHook{levels: []model.Level{model.ErrorLevel}}
+	dedup := logger.NewDedupHook(hook, &model.DedupConfig{Window: time.Minute})
+	assert.Equal(t, hook.levels, dedup.Levels())
+
+	start := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+	fire := func(offset time.Duration, msg string, err error) {
+		record := slog.NewRecord(start.Add(offset), slog.LevelError, msg, 0)
+		record.AddAttrs(slog.Int("attempt", int(offset/time.Second)), slog.Any("error", err))
+		require.NoError(t, dedup.Fire(record))
+	}
+	errDeclined, errTimeout := errors.New("card declined"), errors.New("timeout")
+
+	fire(0, "charge failed", errDeclined)
+	fire(time.Second, "charge failed", errDeclined)
+	fire(2*time.Second, "charge failed", errDeclined)
+	fire(3*time.Second, "charge failed", errTimeout)
+	fire(4*time.Second, "refund failed", errDeclined)
+	fire(time.Minute, "charge failed", errDeclined)
+	fire(time.Minute+time.Second, "charge failed", errDeclined)
+	fire(2*time.Minute+time.Second, "charge failed", errDeclined)
+
+	records := hook.Records()
+	require.Len(t, records, 5)
+	assert.Equal(t, map[string]interface{}{"attempt": int64(0), "error": errDeclined}, recordFields(records[0]))
+	assert.Equal(t, "timeout", recordFields(records[1])["error"].(error).Error())
+	assert.Equal(t, "refund failed", records[2].Message)
+	assert.Equal(t, map[string]interface{}{"attempt": int64(60), "error": errDeclined, "duplicates": int64(2)},
+		recordFields(records[3]))
+	assert.Equal(t, map[string]interface{}{"attempt": int64(121), "error": errDeclined, "duplicates": int64(1)},
+		recordFields(records[4]))
+}
+
+func TestDedupHook_TrailingDuplicates(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+	newRecord := func(offset time.Duration, msg string) slog.Record {
+		record := slog.NewRecord(start.Add(offset), slog.LevelError, msg, 0)
+		record.AddAttrs(slog.Int("attempt", int(offset/time.Second)))
+		return record
+	}
+	tests := []struct {
+		name  string
+		fire  func(t *testing.T, dedup *logger.DedupHook)
+		wantN int
+	}{
+		{
+			name: "window expired by a later record",
+			fire: func(t *testing.T, dedup *logger.DedupHook) {
+				t.Helper()
+				require.NoError(t, dedup.Fire(newRecord(2*time.Hour, "refund failed")))
+			},
+			wantN: 3,
+		},
+		{
+			name: "hook closed",
+			fire: func(t *testing.T, dedup *logger.DedupHook) {
+				t.Helper()
+				require.NoError(t, dedup.Close())
+				require.NoError(t, dedup.Close())
+			},
+			wantN: 2,
+		},
+	}
+	for _, tC := range tests {
+		tt := tC
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			hook := &recordingHook{levels: []model.Level{model.ErrorLevel}}
+			dedup := logger.NewDedupHook(hook, &model.DedupConfig{Window: time.Hour})
+			for offset := range 3 {
+				require.NoError(t, dedup.Fire(newRecord(time.Duration(offset)*time.Second, "charge failed")))
+			}
+			tt.fire(t, dedup)
+
+			records := hook.Records()
+			require.Len(t, records, tt.wantN)
+			assert.Equal(t, map[string]interface{}{"attempt": int64(0)}, recordFields(records[0]))
+			assert.Equal(t, "charge failed", records[1].Message)
+			assert.Equal(t, map[string]interface{}{"attempt": int64(2), "duplicates": int64(2)}, recordFields(records[1]))
+		})
+	}
+}
+
+func TestDedupHook_WindowTimer(t *testing.T) {
+	t.Parallel()
+	hook := &recordingHook{levels: []model.Level{model.ErrorLevel}}
+	dedup := logger.NewDedupHook(hook, &model.DedupConfig{Window: 50 * time.Millisecond})
+	for range 3 {
+		require.NoError(t, dedup.Fire(slog.NewRecord(time.Now(), slog.LevelError, "charge failed", 0)))
+	}
+	require.Len(t, hook.Records(), 1)
+
+	require.Eventually(t, func() bool { return len(hook.Records()) == 2 }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, map[string]interface{}{"duplicates": int64(2)}, recordFields(hook.Records()[1]))
+
+	require.NoError(t, dedup.Close())
+	require.NoError(t, dedup.Fire(slog.NewRecord(time.Now(), slog.LevelError, "charge failed", 0)))
+	require.NoError(t, dedup.Fire(slog.NewRecord(time.Now(), slog.LevelError, "charge failed", 0)))
+	assert.Len(t, hook.Records(), 4, "closed hooks fire every record")
+}
@@ -0,0 +1,102 @@
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"runtime"
+	"strings"
+
+	"go.temporal.io/sdk/converter"
+)
+
+// keys of the attributes added by WithError.
+const (
+	errorKey        = "error"
+	errorDetailsKey = "error_details"
+)
+
+// errorAttrs returns the attributes logged by WithError: the message of err under "error" and, when there is
+// more to tell, an "error_details" group with the messages of its wrapped chain, its stack trace and the
+// details of the Temporal failures it wraps, whose payloads are decoded with dataConverter.
+func errorAttrs(err error, dataConverter converter.DataConverter) []any {
+	if err == nil {
+		return []any{errorKey, err}
+	}
+
+	var details []slog.Attr
+	if chain := errorChain(err); len(chain) > 1 {
+		details = append(details, slog.Any("chain", chain))
+	}
+	if stack := errorStack(err); stack != "" {
+		details = append(details, slog.String("stack", stack))
+	}
+	details = append(details, temporalErrorAttrs(err, dataConverter)...)
+
+	if len(details) == 0 {
+		return []any{errorKey, err}
+	}
+	return []any{errorKey, err, slog.Attr{Key: errorDetailsKey, Value: slog.GroupValue(details...)}}
+}
+
+// errorChain returns the messages of err and of the errors it wraps, following errors.Unwrap.
+func errorChain(err error) []string {
+	var chain []string
+	for ; err != nil; err = errors.Unwrap(err) {
+		chain = append(chain, err.Error())
+	}
+	return chain
+}
+
+// errorStack returns the stack trace of the innermost error of the chain carrying one, which is the closest to
+// where the failure happened. Errors carry a stack trace with a StackTrace method returning either a string, as
+// temporal.PanicError, or program counters as returned by runtime.Callers, as the errors of github.com/pkg/errors.
+func errorStack(err error) string {
+	var stack string
+	for ; err != nil; err = errors.Unwrap(err) {
+		if trace := stackTrace(err); trace != "" {
+			stack = trace
+		}
+	}
+	return stack
+}
+
+func stackTrace(err error) string {
+	if tracer, ok := err.(interface{ StackTrace() string }); ok {
+		return tracer.StackTrace()
+	}
+
+	// the program counters are read through reflection, so that their named types need not be imported
+	method := reflect.ValueOf(err).MethodByName("StackTrace")
+	if !method.IsValid() || method.Type().NumIn() != 0 || method.Type().NumOut() != 1 {
+		return ""
+	}
+	out := method.Type().Out(0)
+	if out.Kind() != reflect.Slice || out.Elem().Kind() != reflect.Uintptr {
+		return ""
+	}
+	frames := method.Call(nil)[0]
+	pcs := make([]uintptr, frames.Len())
+	for i := range pcs {
+		pcs[i] = uintptr(frames.Index(i).Uint())
+	}
+	return formatStack(pcs)
+}
+
+// formatStack formats program counters as runtime/debug.Stack formats the frames of a goroutine.
+func formatStack(pcs []uintptr) string {
+	if len(pcs) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	frames := runtime.CallersFrames(pcs)
+	for {
+		frame, more := frames.Next()
+		fmt.Fprintf(&b, "%s\n\t%s:%d\n", frame.Function, frame.File, frame.Line)
+		if !more {
+			break
+		}
+	}
+	return b.String()
+}
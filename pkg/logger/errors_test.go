@@ -0,0 +1,239 @@
+package logger_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	failurepb "go.temporal.io/api/failure/v1"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/temporal"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger"
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+// frame is a program counter with its own type, as github.com/pkg/errors.Frame.
+type frame uintptr
+
+// stackError carries the stack trace of where it was created.
+type stackError struct {
+	msg string
+	pcs []uintptr
+}
+
+func newStackError(msg string) *stackError {
+	pcs := make([]uintptr, 32)
+	return &stackError{msg: msg, pcs: pcs[:runtime.Callers(1, pcs)]}
+}
+
+func (e *stackError) Error() string { return e.msg }
+
+func (e *stackError) StackTrace() []frame {
+	frames := make([]frame, len(e.pcs))
+	for i, pc := range e.pcs {
+		frames[i] = frame(pc)
+	}
+	return frames
+}
+
+// logError logs err with WithError and returns the decoded JSON entry.
+func logError(t *testing.T, err error) map[string]interface{} {
+	t.Helper()
+	output := new(strings.Builder)
+	logger.NewSlogLogger(&model.Config{Output: output}).WithError(err).Error("failed")
+
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(output.String()), &entry))
+	return entry
+}
+
+func TestSlogLogger_WithErrorChain(t *testing.T) {
+	t.Parallel()
+	errNotFound := errors.New("account not found")
+	tests := []struct {
+		name        string
+		err         error
+		wantDetails interface{}
+	}{
+		{name: "plain", err: errNotFound},
+		{
+			name: "wrapped",
+			err:  fmt.Errorf("charge: %w", fmt.Errorf("lookup: %w", errNotFound)),
+			wantDetails: map[string]interface{}{
+				"chain": []interface{}{"charge: lookup: account not found", "lookup: account not found", "account not found"},
+			},
+		},
+	}
+	for _, tC := range tests {
+		tt := tC
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			entry := logError(t, tt.err)
+			assert.Equal(t, tt.err.Error(), entry["error"])
+			assert.Equal(t, tt.wantDetails, entry["error_details"])
+		})
+	}
+}
+
+func TestSlogLogger_WithErrorStack(t *testing.T) {
+	t.Parallel()
+	err := fmt.Errorf("charge: %w", newStackError("card declined"))
+	details, ok := logError(t, err)["error_details"].(map[string]interface{})
+	require.True(t, ok)
+	stack, ok := details["stack"].(string)
+	require.True(t, ok)
+	assert.True(t, strings.HasPrefix(stack, "github.com/nash-567/goTemporalLoom/pkg/logger_test.newStackError\n"), stack)
+	assert.Contains(t, stack, "logger_test.TestSlogLogger_WithErrorStack\n\t")
+	assert.Contains(t, stack, "errors_test.go:")
+}
+
+func TestSlogLogger_WithErrorTemporal(t *testing.T) {
+	t.Parallel()
+	failureConverter := temporal.GetDefaultFailureConverter()
+	applicationErr := temporal.NewNonRetryableApplicationError("card declined", "CardDeclined", nil,
+		map[string]string{"card": "visa"})
+	activityFailure := &failurepb.Failure{
+		Message: "activity error",
+		FailureInfo: &failurepb.Failure_ActivityFailureInfo{ActivityFailureInfo: &failurepb.ActivityFailureInfo{
+			ScheduledEventId: 5,
+			StartedEventId:   6,
+			Identity:         "worker-1",
+			ActivityType:     &commonpb.ActivityType{Name: "Charge"},
+			ActivityId:       "7",
+			RetryState:       enumspb.RETRY_STATE_NON_RETRYABLE_FAILURE,
+		}},
+		Cause: failureConverter.ErrorToFailure(applicationErr),
+	}
+	childFailure := &failurepb.Failure{
+		Message: "child workflow execution error",
+		FailureInfo: &failurepb.Failure_ChildWorkflowExecutionFailureInfo{
+			ChildWorkflowExecutionFailureInfo: &failurepb.ChildWorkflowExecutionFailureInfo{
+				Namespace:         "default",
+				WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: "payment-42", RunId: "run-1"},
+				WorkflowType:      &commonpb.WorkflowType{Name: "PaymentWorkflow"},
+				InitiatedEventId:  11,
+				StartedEventId:    12,
+				RetryState:        enumspb.RETRY_STATE_NON_RETRYABLE_FAILURE,
+			},
+		},
+		Cause: activityFailure,
+	}
+	err := fmt.Errorf("order 42: %w", failureConverter.FailureToError(childFailure))
+
+	details, ok := logError(t, err)["error_details"].(map[string]interface{})
+	require.True(t, ok)
+	assert.Len(t, details["chain"], 4)
+	assert.Equal(t, map[string]interface{}{
+		"type":          "CardDeclined",
+		"non_retryable": true,
+		"details":       []interface{}{map[string]interface{}{"card": "visa"}},
+	}, details["application"])
+	assert.Equal(t, map[string]interface{}{
+		"type":               "Charge",
+		"id":                 "7",
+		"scheduled_event_id": float64(5),
+		"started_event_id":   float64(6),
+		"identity":           "worker-1",
+		"retry_state":        "NonRetryableFailure",
+	}, details["activity"])
+	assert.Equal(t, map[string]interface{}{
+		"namespace":          "default",
+		"type":               "PaymentWorkflow",
+		"workflow_id":        "payment-42",
+		"run_id":             "run-1",
+		"initiated_event_id": float64(11),
+		"started_event_id":   float64(12),
+		"retry_state":        "NonRetryableFailure",
+	}, details["child_workflow"])
+}
+
+func TestSlogLogger_WithErrorTemporalDetails(t *testing.T) {
+	t.Parallel()
+	codecDataConverter := converter.NewCodecDataConverter(converter.GetDefaultDataConverter(),
+		converter.NewZlibCodec(converter.ZlibCodecOptions{AlwaysEncode: true}))
+	codecFailureConverter := temporal.NewDefaultFailureConverter(temporal.DefaultFailureConverterOptions{
+		DataConverter: codecDataConverter,
+	})
+	encoded := codecFailureConverter.FailureToError(codecFailureConverter.ErrorToFailure(
+		temporal.NewApplicationError("card declined", "CardDeclined", map[string]string{"card": "visa"})))
+	undecodable := &failurepb.Failure{
+		Message: "card declined",
+		FailureInfo: &failurepb.Failure_ApplicationFailureInfo{ApplicationFailureInfo: &failurepb.ApplicationFailureInfo{
+			Type: "CardDeclined",
+			Details: &commonpb.Payloads{Payloads: []*commonpb.Payload{
+				{Metadata: map[string][]byte{"encoding": []byte("json/plain")}, Data: []byte(`"visa"`)},
+				{Metadata: map[string][]byte{"encoding": []byte("binary/encrypted")}, Data: []byte("secret")},
+				{Metadata: map[string][]byte{"encoding": []byte("json/plain")}, Data: []byte(`"mastercard"`)},
+			}},
+		}},
+	}
+	tests := []struct {
+		name          string
+		dataConverter converter.DataConverter
+		err           error
+		want          []interface{}
+	}{
+		{
+			name: "created in the process",
+			err:  temporal.NewApplicationError("card declined", "CardDeclined", "visa", 42, nil),
+			want: []interface{}{"visa", float64(42), nil},
+		},
+		{
+			name:          "encoded by a codec",
+			dataConverter: codecDataConverter,
+			err:           encoded,
+			want:          []interface{}{map[string]interface{}{"card": "visa"}},
+		},
+		{
+			name: "encoded by a codec unknown to the logger",
+			err:  encoded,
+			want: []interface{}{"encoding binary/zlib: payload encoding is not supported"},
+		},
+		{
+			name: "undecodable",
+			err:  temporal.GetDefaultFailureConverter().FailureToError(undecodable),
+			want: []interface{}{"visa", "encoding binary/encrypted: payload encoding is not supported", "mastercard"},
+		},
+	}
+	for _, tC := range tests {
+		tt := tC
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			output := new(strings.Builder)
+			log := logger.NewSlogLogger(&model.Config{Output: output, KeepSlogDefault: true})
+			if tt.dataConverter != nil {
+				log = log.WithDataConverter(tt.dataConverter)
+			}
+			log.WithError(tt.err).Error("failed")
+
+			var entry struct {
+				ErrorDetails struct {
+					Application struct {
+						Details []interface{} `json:"details"`
+					} `json:"application"`
+				} `json:"error_details"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(output.String()), &entry))
+			assert.Equal(t, tt.want, entry.ErrorDetails.Application.Details)
+		})
+	}
+}
+
+func TestSlogLogger_WithErrorTemporalDetailsRedaction(t *testing.T) {
+	t.Parallel()
+	log, output := newRedactingLogger("json")
+	log.WithError(temporal.NewApplicationError("login failed", "InvalidCredentials",
+		map[string]string{"user": "bob", "password": "hunter2"}, "bob@example.com")).Error("failed")
+
+	assert.Contains(t, output.String(), `"details":[{"password":"[REDACTED]","user":"bob"},"[REDACTED]"]`)
+	assert.NotContains(t, output.String(), "hunter2")
+	assert.NotContains(t, output.String(), "example.com")
+}
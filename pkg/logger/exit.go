@@ -0,0 +1,94 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+// ErrFatal is wrapped by the value Fatal panics with when Config.FatalAction is FatalPanic.
+var ErrFatal = errors.New("fatal")
+
+// An ExitHook releases a resource before Fatal exits, such as flushing a writer or stopping a worker.
+// It should give up when ctx is done.
+type ExitHook func(ctx context.Context) error
+
+type namedExitHook struct {
+	name string
+	hook ExitHook
+}
+
+// exitHandler holds what Fatal does after logging. It is shared by a logger and all the loggers derived from it.
+type exitHandler struct {
+	cfg   *model.Config
+	mu    sync.Mutex
+	hooks []namedExitHook
+	once  sync.Once
+}
+
+func newExitHandler(config *model.Config) *exitHandler {
+	return &exitHandler{cfg: config}
+}
+
+// RegisterExitHook registers hook to run before Fatal exits or panics. Hooks run once, in the reverse order
+// of their registration, and are given Config.ExitHookTimeout in total; failures are logged with name.
+// The hooks are shared by the logger and all the loggers derived from it.
+func (log *SlogLogger) RegisterExitHook(name string, hook ExitHook) {
+	log.exit.mu.Lock()
+	defer log.exit.mu.Unlock()
+	log.exit.hooks = append(log.exit.hooks, namedExitHook{name: name, hook: hook})
+}
+
+// fatal runs the exit hooks then exits or panics with msg.
+func (e *exitHandler) fatal(log *SlogLogger, msg string) {
+	e.once.Do(func() { e.runHooks(log) })
+	// the message, and those of the hooks, may still be queued by an asynchronous logger
+	ctx, cancel := context.WithTimeout(context.Background(), e.cfg.GetExitHookTimeout())
+	_ = log.Flush(ctx)
+	cancel()
+
+	if e.cfg.FatalAction == model.FatalPanic {
+		panic(fmt.Errorf("%w: %s", ErrFatal, msg))
+	}
+	exit := e.cfg.ExitFunc
+	if exit == nil {
+		exit = os.Exit
+	}
+	exit(1)
+}
+
+// runHooks runs the hooks, last registered first, until they are done or the timeout expires.
+func (e *exitHandler) runHooks(log *SlogLogger) {
+	e.mu.Lock()
+	hooks := append([]namedExitHook(nil), e.hooks...)
+	e.mu.Unlock()
+	if len(hooks) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), e.cfg.GetExitHookTimeout())
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := len(hooks) - 1; i >= 0; i-- {
+			if ctx.Err() != nil {
+				return
+			}
+			if err := hooks[i].hook(ctx); err != nil {
+				log.WithError(err).WithField("hook", hooks[i].name).Error("exit hook failed")
+			}
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		log.WithField("timeout", e.cfg.GetExitHookTimeout().String()).Error("exit hooks timed out")
+	}
+}
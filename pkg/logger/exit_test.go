@@ -0,0 +1,93 @@
+package logger_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger"
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+func TestSlogLogger_FatalPanic(t *testing.T) {
+	t.Parallel()
+	output := new(strings.Builder)
+	log := logger.NewSlogLogger(&model.Config{Output: output, FatalAction: model.FatalPanic})
+
+	var order []string
+	log.RegisterExitHook("first", func(context.Context) error {
+		order = append(order, "first")
+		return nil
+	})
+	log.Named("worker").RegisterExitHook("second", func(context.Context) error {
+		order = append(order, "second")
+		return nil
+	})
+
+	defer func() {
+		err, ok := recover().(error)
+		require.True(t, ok)
+		require.ErrorIs(t, err, logger.ErrFatal)
+		assert.EqualError(t, err, "fatal: cannot continue")
+		assert.Equal(t, []string{"second", "first"}, order)
+		assert.Contains(t, output.String(), `"level":"FATAL","msg":"cannot continue","order":42`)
+	}()
+	log.WithField("order", 42).Fatal("cannot continue")
+}
+
+func TestSlogLogger_FatalExitFunc(t *testing.T) {
+	t.Parallel()
+	output := new(strings.Builder)
+	var codes []int
+	log := logger.NewSlogLogger(&model.Config{Output: output, ExitFunc: func(code int) { codes = append(codes, code) }})
+
+	calls := 0
+	log.RegisterExitHook("flush", func(context.Context) error {
+		calls++
+		return errors.New("disk full")
+	})
+
+	log.Fatal("first failure")
+	log.FatalCtx(context.Background(), "second failure")
+
+	assert.Equal(t, []int{1, 1}, codes)
+	assert.Equal(t, 1, calls, "exit hooks run once")
+	assert.Contains(t, output.String(), `"msg":"first failure"`)
+	assert.Contains(t, output.String(), `"msg":"second failure"`)
+	assert.Contains(t, output.String(), `"msg":"exit hook failed","error":"disk full","hook":"flush"`)
+}
+
+func TestSlogLogger_FatalHookTimeout(t *testing.T) {
+	t.Parallel()
+	output := new(strings.Builder)
+	exited := false
+	log := logger.NewSlogLogger(&model.Config{
+		Output:          output,
+		ExitFunc:        func(int) { exited = true },
+		ExitHookTimeout: 10 * time.Millisecond,
+	})
+
+	skipped := true
+	log.RegisterExitHook("close", func(context.Context) error {
+		skipped = false
+		return nil
+	})
+	stopped := make(chan struct{})
+	log.RegisterExitHook("stop worker", func(ctx context.Context) error {
+		defer close(stopped)
+		<-ctx.Done()
+		return nil
+	})
+
+	log.Fatal("cannot continue")
+	<-stopped
+
+	assert.True(t, exited)
+	assert.Contains(t, output.String(), `"msg":"exit hooks timed out","timeout":"10ms"`)
+	assert.True(t, skipped, "hooks are not started after the timeout")
+}
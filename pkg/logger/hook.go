@@ -0,0 +1,227 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+// A Hook receives the records logged at its levels, e.g. to forward errors to an error tracker.
+// Records carry the attributes of the logger they were logged with, such as those added by WithField and
+// WithError, and the attributes extracted from their context; they are redacted as the output is.
+//
+// Fire runs synchronously while logging; slow hooks should be wrapped with NewAsyncHook. Errors returned
+// by Fire are written to stderr, since they cannot be logged.
+type Hook interface {
+	// Levels are the levels of the records passed to Fire.
+	Levels() []model.Level
+
+	// Fire handles a record. The record may be kept after Fire returns.
+	Fire(record slog.Record) error
+}
+
+// AddHook adds hook to the logger and all the loggers derived from it, including named loggers, replay-safe
+// loggers and TemporalLogger, so that the messages of workflows and of the Temporal SDK reach it as well.
+// Hooks receive the records emitted by the logger, after filtering by level but before sampling.
+func (log *SlogLogger) AddHook(hook Hook) {
+	log.hooks.add(hook)
+}
+
+// hookRegistry holds the hooks by level. It is shared by a logger and all the loggers derived from it.
+type hookRegistry struct {
+	mu    sync.RWMutex
+	hooks map[slog.Level][]Hook
+}
+
+func newHookRegistry() *hookRegistry {
+	return &hookRegistry{hooks: make(map[slog.Level][]Hook)}
+}
+
+func (r *hookRegistry) add(hook Hook) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, level := range hook.Levels() {
+		r.hooks[level.SlogLevel()] = append(r.hooks[level.SlogLevel()], hook)
+	}
+}
+
+func (r *hookRegistry) forLevel(level slog.Level) []Hook {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.hooks[level]
+}
+
+// hookHandler passes records to the format handler and fires the hooks of their level.
+type hookHandler struct {
+	handler    slog.Handler
+	hooks      *hookRegistry
+	extractors []model.ContextExtractor
+	redactor   *redactor
+	// attrs are the attributes added with WithAttrs, nested in the groups open when they were added.
+	attrs  []slog.Attr
+	groups []string
+}
+
+func newHookHandler(handler slog.Handler, hooks *hookRegistry, config *model.Config) *hookHandler {
+	h := &hookHandler{handler: handler, hooks: hooks, extractors: config.ContextExtractors}
+	if config.Redaction != nil {
+		h.redactor = newRedactor(config.Redaction)
+	}
+	return h
+}
+
+func (h *hookHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.handler.Enabled(ctx, level)
+}
+
+//nolint:wrapcheck // errors are passed through unchanged
+func (h *hookHandler) Handle(ctx context.Context, record slog.Record) error {
+	err := h.handler.Handle(ctx, record)
+	if hooks := h.hooks.forLevel(record.Level); len(hooks) > 0 {
+		hookRecord := h.hookRecord(ctx, record)
+		for _, hook := range hooks {
+			_ = fireHook(hook, hookRecord.Clone())
+		}
+	}
+	return err
+}
+
+//nolint:ireturn // implements slog.Handler interface
+func (h *hookHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+	clone := *h
+	clone.handler = h.handler.WithAttrs(attrs)
+	clone.attrs = append(append([]slog.Attr(nil), h.attrs...), nestAttrs(h.groups, attrs)...)
+	return &clone
+}
+
+//nolint:ireturn // implements slog.Handler interface
+func (h *hookHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	clone := *h
+	clone.handler = h.handler.WithGroup(name)
+	clone.groups = append(append([]string(nil), h.groups...), name)
+	return &clone
+}
+
+// hookRecord returns record with the attributes of the handler and of its context, redacted.
+func (h *hookHandler) hookRecord(ctx context.Context, record slog.Record) slog.Record {
+	var attrs []slog.Attr
+	record.Attrs(func(a slog.Attr) bool {
+		attrs = append(attrs, a)
+		return true
+	})
+	for _, extract := range h.extractors {
+		attrs = append(attrs, extract(ctx)...)
+	}
+	attrs = append(append([]slog.Attr(nil), h.attrs...), nestAttrs(h.groups, attrs)...)
+
+	message := record.Message
+	if h.redactor != nil {
+		message = h.redactor.redactString(message)
+		attrs = h.redactAttrs(nil, attrs)
+	}
+	hookRecord := slog.NewRecord(record.Time, record.Level, message, record.PC)
+	hookRecord.AddAttrs(attrs...)
+	return hookRecord
+}
+
+// redactAttrs applies the redactor to attrs as slog.HandlerOptions.ReplaceAttr is applied by the format handlers.
+func (h *hookHandler) redactAttrs(groups []string, attrs []slog.Attr) []slog.Attr {
+	redacted := make([]slog.Attr, 0, len(attrs))
+	for _, a := range attrs {
+		a.Value = a.Value.Resolve()
+		if a.Value.Kind() == slog.KindGroup {
+			a.Value = slog.GroupValue(h.redactAttrs(append(groups, a.Key), a.Value.Group())...)
+		} else {
+			a = h.redactor.replaceAttr(groups, a)
+		}
+		redacted = append(redacted, a)
+	}
+	return redacted
+}
+
+// nestAttrs nests attrs in groups, the first group being the outermost.
+func nestAttrs(groups []string, attrs []slog.Attr) []slog.Attr {
+	for i := len(groups) - 1; i >= 0 && len(attrs) > 0; i-- {
+		attrs = []slog.Attr{{Key: groups[i], Value: slog.GroupValue(attrs...)}}
+	}
+	return attrs
+}
+
+func fireHook(hook Hook, record slog.Record) error {
+	if err := hook.Fire(record); err != nil {
+		fmt.Fprintf(os.Stderr, "log hook %T: %v\n", hook, err)
+		return fmt.Errorf("fire log hook: %w", err)
+	}
+	return nil
+}
+
+// AsyncHook fires a hook from a background goroutine, so that logging does not wait for it, e.g. for
+// a request to an error tracker. Records are queued as by an AsyncHandler.
+type AsyncHook struct {
+	hook    Hook
+	handler *AsyncHandler
+}
+
+var _ Hook = (*AsyncHook)(nil)
+
+// NewAsyncHook returns a hook firing hook from a background goroutine, which runs until Close.
+func NewAsyncHook(hook Hook, config *model.AsyncConfig) *AsyncHook {
+	return &AsyncHook{hook: hook, handler: NewAsyncHandler(&hookAdapter{hook: hook}, config)}
+}
+
+func (h *AsyncHook) Levels() []model.Level {
+	return h.hook.Levels()
+}
+
+// Fire queues record. Once the hook is closed, records are fired synchronously.
+func (h *AsyncHook) Fire(record slog.Record) error {
+	return h.handler.Handle(context.Background(), record)
+}
+
+// Flush waits until the records queued before the call are fired, or until ctx is done.
+func (h *AsyncHook) Flush(ctx context.Context) error {
+	return h.handler.Flush(ctx)
+}
+
+// Close waits until the queued records are fired, or until ctx is done.
+func (h *AsyncHook) Close(ctx context.Context) error {
+	return h.handler.Close(ctx)
+}
+
+// Stats returns the counters of the queue; Failed counts the errors returned by the hook.
+func (h *AsyncHook) Stats() AsyncStats {
+	return h.handler.Stats()
+}
+
+// hookAdapter fires a hook as a slog.Handler, so that its records can be queued by an AsyncHandler.
+type hookAdapter struct {
+	hook Hook
+}
+
+func (h *hookAdapter) Enabled(context.Context, slog.Level) bool {
+	return true
+}
+
+func (h *hookAdapter) Handle(_ context.Context, record slog.Record) error {
+	return fireHook(h.hook, record)
+}
+
+//nolint:ireturn // implements slog.Handler interface
+func (h *hookAdapter) WithAttrs([]slog.Attr) slog.Handler {
+	return h
+}
+
+//nolint:ireturn // implements slog.Handler interface
+func (h *hookAdapter) WithGroup(string) slog.Handler {
+	return h
+}
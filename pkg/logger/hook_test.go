@@ -0,0 +1,137 @@
+package logger_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger"
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+// recordingHook records the records it is fired with.
+type recordingHook struct {
+	levels  []model.Level
+	err     error
+	mu      sync.Mutex
+	records []slog.Record
+}
+
+func (h *recordingHook) Levels() []model.Level { return h.levels }
+
+func (h *recordingHook) Fire(record slog.Record) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.records = append(h.records, record)
+	return h.err
+}
+
+func (h *recordingHook) Records() []slog.Record {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]slog.Record(nil), h.records...)
+}
+
+// recordFields returns the attributes of record, groups being nested maps and values as logged.
+func recordFields(record slog.Record) map[string]interface{} {
+	var attrs []slog.Attr
+	record.Attrs(func(a slog.Attr) bool {
+		attrs = append(attrs, a)
+		return true
+	})
+	return fieldsOf(attrs)
+}
+
+func fieldsOf(attrs []slog.Attr) map[string]interface{} {
+	fields := make(map[string]interface{}, len(attrs))
+	for _, a := range attrs {
+		if a.Value.Kind() == slog.KindGroup {
+			fields[a.Key] = fieldsOf(a.Value.Group())
+			continue
+		}
+		fields[a.Key] = a.Value.Any()
+	}
+	return fields
+}
+
+type requestIDKey struct{}
+
+func TestSlogLogger_AddHook(t *testing.T) {
+	t.Parallel()
+	output := new(strings.Builder)
+	log := logger.NewSlogLogger(&model.Config{
+		Output:            output,
+		Redaction:         &model.RedactionConfig{Keys: []string{"password"}},
+		ContextExtractors: []model.ContextExtractor{logger.ExtractContextValue("request_id", requestIDKey{})},
+	})
+	hook := &recordingHook{levels: []model.Level{model.ErrorLevel, model.FatalLevel}}
+	payments := log.Named("payments")
+	log.AddHook(hook)
+
+	errDeclined := errors.New("card declined")
+	ctx := context.WithValue(context.Background(), requestIDKey{}, "req-1")
+	payments.WithField("password", "secret").WithError(errDeclined).ErrorCtx(ctx, "charge failed")
+	payments.Info("charged")
+	keyVal, ok := payments.ToKeyValLogger().(*slog.Logger)
+	require.True(t, ok)
+	keyVal.With("order", 42).WithGroup("payment").Error("refund failed", "amount", 10)
+	logger.NewTemporalLogger(log).Error("workflow task failed")
+
+	records := hook.Records()
+	require.Len(t, records, 3)
+	assert.Equal(t, "charge failed", records[0].Message)
+	assert.Equal(t, map[string]interface{}{
+		"logger":     "payments",
+		"password":   "[REDACTED]",
+		"error":      errDeclined.Error(), // redacted values are copied, errors as their message
+		"request_id": "req-1",
+	}, recordFields(records[0]))
+	assert.Equal(t, map[string]interface{}{
+		"logger":  "payments",
+		"order":   int64(42),
+		"payment": map[string]interface{}{"amount": int64(10)},
+	}, recordFields(records[1]))
+	assert.Equal(t, "workflow task failed", records[2].Message)
+	assert.Equal(t, 3, strings.Count(output.String(), `"level":"ERROR"`))
+}
+
+func TestSlogLogger_AddHookSampling(t *testing.T) {
+	t.Parallel()
+	output := new(strings.Builder)
+	log := logger.NewSlogLogger(&model.Config{
+		Output:   output,
+		Sampling: &model.SamplingConfig{Initial: 1, Interval: time.Hour},
+	})
+	hook := &recordingHook{levels: []model.Level{model.ErrorLevel}}
+	log.AddHook(hook)
+
+	for range 3 {
+		log.Error("retry failed")
+	}
+	assert.Len(t, hook.Records(), 3, "hooks receive the records dropped by sampling")
+	assert.Equal(t, 1, strings.Count(output.String(), "retry failed"))
+}
+
+func TestAsyncHook(t *testing.T) {
+	t.Parallel()
+	hook := &recordingHook{levels: []model.Level{model.ErrorLevel}, err: errors.New("tracker unavailable")}
+	async := logger.NewAsyncHook(hook, &model.AsyncConfig{QueueSize: 16})
+	assert.Equal(t, hook.levels, async.Levels())
+
+	log := logger.NewSlogLogger(&model.Config{Output: new(strings.Builder)})
+	log.AddHook(async)
+	log.Error("first")
+	log.Error("second")
+
+	require.NoError(t, async.Flush(context.Background()))
+	require.Len(t, hook.Records(), 2)
+	assert.Equal(t, logger.AsyncStats{Written: 2, Failed: 2}, async.Stats())
+	require.NoError(t, async.Close(context.Background()))
+}
@@ -0,0 +1,209 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+// LevelController changes the level of a SlogLogger at runtime, through HTTP and OS signals.
+// When a TTL is set, the level reverts to the configured level once the TTL elapsed since the last change,
+// so that a worker left at DEBUG while investigating does not stay verbose.
+type LevelController struct {
+	log        *SlogLogger
+	configured model.Level
+	ttl        time.Duration
+	mu         sync.Mutex
+	revert     *time.Timer
+	revertAt   time.Time
+	// generation identifies the latest change, so that a revert timer firing late is ignored.
+	generation int
+}
+
+// levelRequest is the body of PUT /loglevel. TTL overrides the TTL of the controller; "0s" disables the revert.
+type levelRequest struct {
+	Level string `json:"level"`
+	TTL   string `json:"ttl,omitempty"`
+}
+
+// levelResponse is the body returned by GET and PUT /loglevel.
+type levelResponse struct {
+	Level      string     `json:"level"`
+	Configured string     `json:"configured"`
+	RevertAt   *time.Time `json:"revert_at,omitempty"`
+}
+
+// NewLevelController returns a LevelController for log. The current level of log is the configured level
+// restored after ttl; a zero ttl keeps changes until the next one.
+func NewLevelController(log *SlogLogger, ttl time.Duration) *LevelController {
+	return &LevelController{
+		log:        log,
+		configured: log.GetLevel(),
+		ttl:        ttl,
+	}
+}
+
+// SetLevel sets the level of the logger and schedules the revert to the configured level.
+func (c *LevelController) SetLevel(level model.Level) {
+	c.setLevel(level, c.ttl)
+}
+
+// MoreVerbose steps the level down, e.g. from INFO to DEBUG.
+func (c *LevelController) MoreVerbose() {
+	c.step(-1)
+}
+
+// LessVerbose steps the level up, e.g. from INFO to WARN.
+func (c *LevelController) LessVerbose() {
+	c.step(1)
+}
+
+// NotifySignals steps the level down on SIGUSR1 and up on SIGUSR2, where these signals exist.
+// The returned function stops listening for the signals.
+func (c *LevelController) NotifySignals() func() {
+	if len(levelSignals) == 0 {
+		return func() {}
+	}
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, levelSignals...)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for sig := range signals {
+			if sig == levelSignals[0] {
+				c.MoreVerbose()
+			} else {
+				c.LessVerbose()
+			}
+		}
+	}()
+	return func() {
+		signal.Stop(signals)
+		close(signals)
+		<-done
+	}
+}
+
+// Stop cancels the pending revert, if any, leaving the level unchanged.
+func (c *LevelController) Stop() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stopRevert()
+}
+
+// ServeHTTP returns the current level on GET and changes it on PUT with a JSON body such as
+// {"level": "debug", "ttl": "15m"}.
+func (c *LevelController) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPut:
+		var req levelRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, fmt.Sprintf("decode request: %v", err), http.StatusBadRequest)
+			return
+		}
+		level, err := model.ParseLevelStrict(req.Level)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		ttl := c.ttl
+		if req.TTL != "" {
+			if ttl, err = time.ParseDuration(req.TTL); err != nil || ttl < 0 {
+				http.Error(w, fmt.Sprintf("invalid ttl %q", req.TTL), http.StatusBadRequest)
+				return
+			}
+		}
+		c.setLevel(level, ttl)
+	default:
+		w.Header().Set("Allow", http.MethodGet+", "+http.MethodPut)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(c.status())
+}
+
+func (c *LevelController) status() levelResponse {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	resp := levelResponse{
+		Level:      c.log.GetLevel().String(),
+		Configured: c.configured.String(),
+	}
+	if c.revert != nil {
+		revertAt := c.revertAt
+		resp.RevertAt = &revertAt
+	}
+	return resp
+}
+
+func (c *LevelController) step(delta int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	current := c.log.GetLevel()
+	level := model.Level(min(max(int(current)+delta, int(model.DebugLevel)), int(model.FatalLevel)))
+	if level == current {
+		return
+	}
+	c.setLevelLocked(level, c.ttl)
+}
+
+func (c *LevelController) setLevel(level model.Level, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.setLevelLocked(level, ttl)
+}
+
+func (c *LevelController) setLevelLocked(level model.Level, ttl time.Duration) {
+	c.stopRevert()
+	c.generation++
+	if ttl > 0 && level != c.configured {
+		generation := c.generation
+		c.revertAt = time.Now().Add(ttl)
+		c.revert = time.AfterFunc(ttl, func() { c.revertLevel(generation) })
+	}
+	c.changeLevel(level, model.Fields{"ttl": ttl.String()}, "log level changed")
+}
+
+func (c *LevelController) revertLevel(generation int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+	c.revert = nil
+	c.changeLevel(c.configured, model.Fields{}, "log level reverted")
+}
+
+// changeLevel sets the level and logs the change at WARN with the more verbose of the two levels,
+// so that the change is logged unless both levels are above WARN.
+func (c *LevelController) changeLevel(level model.Level, fields model.Fields, msg string) {
+	previous := c.log.GetLevel()
+	fields["previous_level"] = previous.String()
+	fields["new_level"] = level.String()
+	if level < previous {
+		c.log.SetLevel(level)
+		c.log.WithFields(fields).Warn(msg)
+		return
+	}
+	c.log.WithFields(fields).Warn(msg)
+	c.log.SetLevel(level)
+}
+
+func (c *LevelController) stopRevert() {
+	if c.revert != nil {
+		c.revert.Stop()
+		c.revert = nil
+	}
+}
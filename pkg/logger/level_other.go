@@ -0,0 +1,10 @@
+//go:build !unix
+
+package logger
+
+import "os"
+
+// SIGUSR1 and SIGUSR2 only exist on unix systems.
+//
+//nolint:gochecknoglobals // constant list of signals
+var levelSignals []os.Signal
@@ -0,0 +1,138 @@
+package logger_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger"
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+func newLevelController(ttl time.Duration) (*logger.LevelController, *logger.SlogLogger, *strings.Builder) {
+	output := new(strings.Builder)
+	log := logger.NewSlogLogger(&model.Config{Output: output, Level: "info"})
+	return logger.NewLevelController(log, ttl), log, output
+}
+
+func TestLevelController_ServeHTTP(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		method     string
+		body       string
+		wantStatus int
+		wantLevel  model.Level
+		wantBody   string
+	}{
+		{
+			name:       "get",
+			method:     http.MethodGet,
+			wantStatus: http.StatusOK,
+			wantLevel:  model.InfoLevel,
+			wantBody:   `{"level":"INFO","configured":"INFO"}`,
+		},
+		{
+			name:       "put",
+			method:     http.MethodPut,
+			body:       `{"level":"debug"}`,
+			wantStatus: http.StatusOK,
+			wantLevel:  model.DebugLevel,
+			wantBody:   `{"level":"DEBUG","configured":"INFO"}`,
+		},
+		{
+			name:       "put with ttl",
+			method:     http.MethodPut,
+			body:       `{"level":"debug","ttl":"1h"}`,
+			wantStatus: http.StatusOK,
+			wantLevel:  model.DebugLevel,
+			wantBody:   `"revert_at"`,
+		},
+		{
+			name:       "unknown level",
+			method:     http.MethodPut,
+			body:       `{"level":"verbose"}`,
+			wantStatus: http.StatusBadRequest,
+			wantLevel:  model.InfoLevel,
+			wantBody:   "unknown log level",
+		},
+		{
+			name:       "invalid ttl",
+			method:     http.MethodPut,
+			body:       `{"level":"debug","ttl":"soon"}`,
+			wantStatus: http.StatusBadRequest,
+			wantLevel:  model.InfoLevel,
+		},
+		{
+			name:       "invalid body",
+			method:     http.MethodPut,
+			body:       `debug`,
+			wantStatus: http.StatusBadRequest,
+			wantLevel:  model.InfoLevel,
+		},
+		{
+			name:       "method not allowed",
+			method:     http.MethodPost,
+			wantStatus: http.StatusMethodNotAllowed,
+			wantLevel:  model.InfoLevel,
+		},
+	}
+	for _, tC := range tests {
+		tt := tC
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			controller, log, _ := newLevelController(0)
+			defer controller.Stop()
+
+			rec := httptest.NewRecorder()
+			controller.ServeHTTP(rec, httptest.NewRequest(tt.method, "/loglevel", strings.NewReader(tt.body)))
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, tt.wantLevel, log.GetLevel())
+			assert.Contains(t, rec.Body.String(), tt.wantBody)
+		})
+	}
+}
+
+func TestLevelController_Step(t *testing.T) {
+	t.Parallel()
+	controller, log, output := newLevelController(0)
+
+	controller.MoreVerbose()
+	assert.Equal(t, model.DebugLevel, log.GetLevel())
+	controller.MoreVerbose()
+	assert.Equal(t, model.DebugLevel, log.GetLevel())
+	assert.Equal(t, 1, strings.Count(output.String(), `"msg":"log level changed"`))
+	assert.Contains(t, output.String(), `"previous_level":"INFO"`)
+	assert.Contains(t, output.String(), `"new_level":"DEBUG"`)
+
+	for range 10 {
+		controller.LessVerbose()
+	}
+	assert.Equal(t, model.FatalLevel, log.GetLevel())
+}
+
+func TestLevelController_Revert(t *testing.T) {
+	t.Parallel()
+	controller, log, _ := newLevelController(20 * time.Millisecond)
+
+	controller.SetLevel(model.DebugLevel)
+	assert.Equal(t, model.DebugLevel, log.GetLevel())
+	require.Eventually(t, func() bool { return log.GetLevel() == model.InfoLevel }, time.Second, time.Millisecond)
+
+	// a change without ttl is kept
+	rec := httptest.NewRecorder()
+	controller.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/loglevel", strings.NewReader(`{"level":"warn","ttl":"0s"}`)))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var status map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	assert.NotContains(t, status, "revert_at")
+	time.Sleep(40 * time.Millisecond)
+	assert.Equal(t, model.WarnLevel, log.GetLevel())
+}
@@ -0,0 +1,13 @@
+//go:build unix
+
+package logger
+
+import (
+	"os"
+	"syscall"
+)
+
+// signals stepping the level down and up, in that order.
+//
+//nolint:gochecknoglobals // constant list of signals
+var levelSignals = []os.Signal{syscall.SIGUSR1, syscall.SIGUSR2}
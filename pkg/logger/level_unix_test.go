@@ -0,0 +1,29 @@
+//go:build unix
+
+package logger_test
+
+import (
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+func TestLevelController_NotifySignals(t *testing.T) {
+	t.Parallel()
+	controller, log, _ := newLevelController(0)
+	stop := controller.NotifySignals()
+	defer stop()
+
+	process, err := os.FindProcess(os.Getpid())
+	require.NoError(t, err)
+	require.NoError(t, process.Signal(syscall.SIGUSR1))
+	require.Eventually(t, func() bool { return log.GetLevel() == model.DebugLevel }, time.Second, time.Millisecond)
+
+	require.NoError(t, process.Signal(syscall.SIGUSR2))
+	require.Eventually(t, func() bool { return log.GetLevel() == model.InfoLevel }, time.Second, time.Millisecond)
+}
@@ -2,10 +2,14 @@ package logger
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"sync"
 
+	"go.temporal.io/sdk/converter"
+
 	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
 )
 
@@ -32,33 +36,155 @@ func defaultLogger() *SlogLogger {
 type SlogLogger struct {
 	entry *slog.Logger
 	cfg   *model.Config
-	level *slog.LevelVar
+	// handler is the handler of entry without the name of the logger, from which named loggers are derived.
+	handler slog.Handler
+	levels  *componentLevels
+	exit    *exitHandler
+	// async are the queues of the output or of each sink, when Config.Async is set.
+	async    []*AsyncHandler
+	sampling *samplingHandler
+	hooks    *hookRegistry
+	name     string
+	// dataConverter decodes the details of the Temporal errors logged with WithError.
+	dataConverter converter.DataConverter
 }
 
+// NewSlogLogger returns a logger writing as described by config. Invalid levels and formats fall back to
+// INFO and json, and the validation error is logged as a warning; use NewSlogLoggerE to fail instead.
 func NewSlogLogger(config *model.Config) *SlogLogger {
-	loggingLevel := new(slog.LevelVar)
-	loggingLevel.Set(config.GetSlogLevel())
-	s := &SlogLogger{
-		entry: buildLogger(config, loggingLevel),
-		cfg:   config,
-		level: loggingLevel,
+	log := newSlogLogger(config)
+	if err := config.Validate(); err != nil {
+		log.WithError(err).Warn("invalid logger config, falling back to defaults")
+	}
+	return log
+}
+
+// NewSlogLoggerE is NewSlogLogger failing with the error of config.Validate for an invalid config.
+func NewSlogLoggerE(config *model.Config) (*SlogLogger, error) {
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid logger config: %w", err)
+	}
+	return newSlogLogger(config), nil
+}
+
+func newSlogLogger(config *model.Config) *SlogLogger {
+	levels := newComponentLevels(config)
+	hooks := newHookRegistry()
+	handler, async, sampling := buildLogger(config, levels, hooks)
+	root := &SlogLogger{
+		cfg:           config,
+		levels:        levels,
+		exit:          newExitHandler(config),
+		async:         async,
+		sampling:      sampling,
+		hooks:         hooks,
+		dataConverter: converter.GetDefaultDataConverter(),
 	}
+	s := root.derive(handler, "")
 
+	// output from the log package's default Logger (as with log.Print, etc.) will be logged using slog Handler
+	if !config.KeepSlogDefault {
+		slog.SetDefault(s.entry)
+	}
 	return s
 }
 
-func buildLogger(config *model.Config, level slog.Leveler) *slog.Logger {
-	handler := slog.NewJSONHandler(config.Output, &slog.HandlerOptions{
+// derive returns a logger named name writing through handler, sharing the levels and exit hooks of log.
+func (log *SlogLogger) derive(handler slog.Handler, name string) *SlogLogger {
+	named := handler
+	if name != "" {
+		named = handler.WithAttrs([]slog.Attr{slog.String(loggerKey, name)})
+	}
+	return &SlogLogger{
+		entry:         slog.New(&componentHandler{handler: named, name: name, levels: log.levels}),
+		cfg:           log.cfg,
+		handler:       handler,
+		levels:        log.levels,
+		exit:          log.exit,
+		async:         log.async,
+		sampling:      log.sampling,
+		hooks:         log.hooks,
+		name:          name,
+		dataConverter: log.dataConverter,
+	}
+}
+
+// buildLogger returns the handler of the root logger. Its level is the lowest level of the logger and of
+// its named loggers, which are filtered by their own level before reaching it. The asynchronous handlers
+// writing to the outputs, one per sink, and the sampling handler are returned as well when Config.Async and
+// Config.Sampling are set. Hooks are fired before sampling.
+//
+//nolint:ireturn // the handler depends on the configuration
+func buildLogger(
+	config *model.Config, level slog.Leveler, hooks *hookRegistry,
+) (slog.Handler, []*AsyncHandler, *samplingHandler) {
+	handler, async := buildHandler(config, level)
+	if len(config.ContextExtractors) > 0 {
+		handler = NewContextHandler(handler, config.ContextExtractors...)
+	}
+	var sampling *samplingHandler
+	if config.Sampling != nil {
+		sampling = newSamplingHandler(handler, config.Sampling)
+		handler = sampling
+	}
+	return newHookHandler(handler, hooks, config), async, sampling
+}
+
+// buildHandler returns the handler for the configured format, or a multi-handler over the configured sinks.
+// All formats and sinks share the same options, so custom level labels are rendered consistently.
+// With Config.Async, the output or each sink is written from its own queue, so that a blocked sink does not
+// hold up the others; the asynchronous handlers are returned.
+//
+//nolint:ireturn // the handler depends on the configured format
+func buildHandler(config *model.Config, level slog.Leveler) (slog.Handler, []*AsyncHandler) {
+	opts := &slog.HandlerOptions{
 		AddSource:   config.IncludeSource,
 		Level:       level,
 		ReplaceAttr: replaceAttribute,
-	})
+	}
+	if config.Redaction != nil {
+		redactor := newRedactor(config.Redaction)
+		opts.ReplaceAttr = func(groups []string, a slog.Attr) slog.Attr {
+			return replaceAttribute(groups, redactor.replaceAttr(groups, a))
+		}
+	}
 
-	l := slog.New(handler)
+	var async []*AsyncHandler
+	queue := func(handler slog.Handler) slog.Handler {
+		if config.Async == nil {
+			return handler
+		}
+		queued := NewAsyncHandler(handler, config.Async)
+		async = append(async, queued)
+		return queued
+	}
+	if len(config.Sinks) == 0 {
+		return queue(buildFormatHandler(config.Output, config.GetFormat(), opts)), async
+	}
 
-	// output from the log package's default Logger (as with log.Print, etc.) will be logged using slog Handler
-	slog.SetDefault(l)
-	return l
+	handlers := make([]slog.Handler, 0, len(config.Sinks))
+	for i := range config.Sinks {
+		sink := &config.Sinks[i]
+		sinkOpts := *opts
+		if sink.Level != "" {
+			sinkOpts.Level = &sinkLeveler{logger: level, sink: model.ParseLevel(sink.Level).SlogLevel()}
+		}
+		handlers = append(handlers, queue(buildFormatHandler(sink.Output, sink.GetFormat(), &sinkOpts)))
+	}
+	return NewMultiHandler(handlers...), async
+}
+
+//nolint:ireturn // the handler depends on the format
+func buildFormatHandler(output io.Writer, format model.Format, opts *slog.HandlerOptions) slog.Handler {
+	switch format {
+	case model.FormatText:
+		return slog.NewTextHandler(output, opts)
+	case model.FormatConsole:
+		// colors can be disabled following the https://no-color.org convention
+		return newConsoleHandler(output, opts, os.Getenv("NO_COLOR") == "")
+	default:
+		return slog.NewJSONHandler(output, opts)
+	}
 }
 
 // func to map the custom log levels to their respective labels
@@ -90,21 +216,40 @@ func (log *SlogLogger) Warn(msg string) {
 	log.entry.Warn(msg)
 }
 
+// Fatal logs msg, runs the exit hooks and exits the process, or panics when Config.FatalAction is FatalPanic.
 func (log *SlogLogger) Fatal(msg string) {
 	log.entry.Log(context.Background(), model.LevelFatal, msg)
-	os.Exit(1)
+	log.exit.fatal(log, msg)
 }
 
 func (log *SlogLogger) Error(msg string) {
 	log.entry.Error(msg)
 }
 
+func (log *SlogLogger) DebugCtx(ctx context.Context, msg string) {
+	log.entry.DebugContext(ctx, msg)
+}
+
+func (log *SlogLogger) InfoCtx(ctx context.Context, msg string) {
+	log.entry.InfoContext(ctx, msg)
+}
+
+func (log *SlogLogger) WarnCtx(ctx context.Context, msg string) {
+	log.entry.WarnContext(ctx, msg)
+}
+
+func (log *SlogLogger) ErrorCtx(ctx context.Context, msg string) {
+	log.entry.ErrorContext(ctx, msg)
+}
+
+func (log *SlogLogger) FatalCtx(ctx context.Context, msg string) {
+	log.entry.Log(ctx, model.LevelFatal, msg)
+	log.exit.fatal(log, msg)
+}
+
 //nolint:ireturn // implements model.Logger interface
 func (log *SlogLogger) WithField(key string, value interface{}) model.Logger {
-	return &SlogLogger{
-		entry: log.entry.With(key, value),
-		level: log.level,
-	}
+	return log.with(key, value)
 }
 
 //nolint:ireturn // implements model.Logger interface
@@ -113,22 +258,33 @@ func (log *SlogLogger) WithFields(fields model.Fields) model.Logger {
 	for key, value := range fields {
 		sFields = append(sFields, key, value)
 	}
-	return &SlogLogger{
-		entry: log.entry.With(sFields...),
-		level: log.level,
-	}
+	return log.with(sFields...)
 }
 
+// WithError returns a logger adding the message of err under "error". The messages of the errors it wraps,
+// its stack trace and the details of the Temporal failures in its chain are added to an "error_details" group.
+//
 //nolint:ireturn // implements model.Logger interface
 func (log *SlogLogger) WithError(err error) model.Logger {
-	return &SlogLogger{
-		entry: log.entry.With("error", err),
-		level: log.level,
-	}
+	return log.with(errorAttrs(err, log.dataConverter)...)
+}
+
+// WithDataConverter returns a logger decoding the details of the Temporal application errors logged with
+// WithError with dataConverter instead of the default data converter of the SDK. It should be the data
+// converter of the client, so that details encoded by a codec are decoded.
+func (log *SlogLogger) WithDataConverter(dataConverter converter.DataConverter) *SlogLogger {
+	derived := log.derive(log.handler, log.name)
+	derived.dataConverter = dataConverter
+	return derived
 }
 
+func (log *SlogLogger) with(args ...any) *SlogLogger {
+	return log.derive(slog.New(log.handler).With(args...).Handler(), log.name)
+}
+
+// SetLevel sets the level of the logger. For a named logger, it overrides the level for its name.
 func (log *SlogLogger) SetLevel(lvl model.Level) {
-	log.level.Set(lvl.SlogLevel())
+	log.levels.set(log.name, lvl.SlogLevel())
 }
 
 //nolint:ireturn // implements model.Logger interface
@@ -136,8 +292,14 @@ func (log *SlogLogger) ToKeyValLogger() model.KeyValLogger {
 	return log.entry
 }
 
+// GetLevel returns the level of the logger. For a named logger, it is the level for its name.
 func (log *SlogLogger) GetLevel() model.Level {
-	return model.ParseLevel(log.level.Level().String())
+	level := log.levels.level(log.name)
+	// slog has no name for the custom fatal level
+	if level >= model.LevelFatal {
+		return model.FatalLevel
+	}
+	return model.ParseLevel(level.String())
 }
 
 // map contains custom slog levels.
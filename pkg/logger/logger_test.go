@@ -2,6 +2,7 @@ package logger_test
 
 import (
 	"errors"
+	"log/slog"
 	"os"
 	"os/exec"
 	"reflect"
@@ -10,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/nash-567/goTemporalLoom/pkg/logger"
 	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
@@ -107,6 +109,41 @@ func TestNewSlogLogger(t *testing.T) {
 	}
 }
 
+func TestNewSlogLogger_InvalidConfig(t *testing.T) {
+	t.Parallel()
+	output := new(strings.Builder)
+	config := &model.Config{Output: output, Level: "verbose"}
+
+	_, err := logger.NewSlogLoggerE(config)
+	require.ErrorContains(t, err, "invalid logger config: level:")
+	assert.ErrorIs(t, err, model.ErrUnknownLevel)
+	assert.Empty(t, output.String())
+
+	log := logger.NewSlogLogger(config)
+	outputMustMatch(t, "NewSlogLogger", output.String(), []string{
+		`"level":"WARN"`, `"msg":"invalid logger config, falling back to defaults"`, `"error":"level: .*verbose`,
+	})
+	log.Debug("not logged")
+	assert.NotContains(t, output.String(), "not logged")
+
+	log, err = logger.NewSlogLoggerE(&model.Config{Output: output, Level: "debug"})
+	require.NoError(t, err)
+	log.Debug("logged")
+	assert.Contains(t, output.String(), "logged")
+}
+
+//nolint:paralleltest // the default slog logger is global
+func TestNewSlogLogger_KeepSlogDefault(t *testing.T) {
+	previous := slog.Default()
+	t.Cleanup(func() { slog.SetDefault(previous) })
+
+	logger.NewSlogLogger(&model.Config{Output: new(strings.Builder), KeepSlogDefault: true})
+	assert.Same(t, previous, slog.Default())
+
+	log := logger.NewSlogLogger(&model.Config{Output: new(strings.Builder)})
+	assert.Same(t, log.ToKeyValLogger(), slog.Default())
+}
+
 func TestSlogLogger_Debug(t *testing.T) {
 	t.Parallel()
 	slogLogger, output := makeTestLogger()
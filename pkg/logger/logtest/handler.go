@@ -0,0 +1,91 @@
+package logtest
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+// handler is the slog.Handler of ToKeyValLogger. Attributes become fields, named after their groups.
+type handler struct {
+	log    *Logger
+	attrs  model.Fields
+	groups []string
+}
+
+func (h *handler) Enabled(_ context.Context, level slog.Level) bool {
+	return fromSlogLevel(level) >= h.log.GetLevel()
+}
+
+func (h *handler) Handle(_ context.Context, record slog.Record) error {
+	fields := make(model.Fields, len(h.attrs)+record.NumAttrs())
+	for key, value := range h.attrs {
+		fields[key] = value
+	}
+	record.Attrs(func(a slog.Attr) bool {
+		addAttr(fields, h.groups, a)
+		return true
+	})
+
+	log := h.log
+	if err, ok := fields[errorKey].(error); ok && len(h.groups) == 0 {
+		delete(fields, errorKey)
+		log = &Logger{rec: log.rec, fields: log.fields, err: err}
+	}
+	log.log(fromSlogLevel(record.Level), record.Message, fields)
+	return nil
+}
+
+//nolint:ireturn // implements slog.Handler interface
+func (h *handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	fields := make(model.Fields, len(h.attrs)+len(attrs))
+	for key, value := range h.attrs {
+		fields[key] = value
+	}
+	for _, a := range attrs {
+		addAttr(fields, h.groups, a)
+	}
+	return &handler{log: h.log, attrs: fields, groups: h.groups}
+}
+
+//nolint:ireturn // implements slog.Handler interface
+func (h *handler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	return &handler{log: h.log, attrs: h.attrs, groups: append(append([]string(nil), h.groups...), name)}
+}
+
+func addAttr(fields model.Fields, groups []string, a slog.Attr) {
+	value := a.Value.Resolve()
+	if value.Kind() == slog.KindGroup {
+		if a.Key != "" {
+			groups = append(append([]string(nil), groups...), a.Key)
+		}
+		for _, ga := range value.Group() {
+			addAttr(fields, groups, ga)
+		}
+		return
+	}
+	if a.Key == "" {
+		return
+	}
+	fields[strings.Join(append(append([]string(nil), groups...), a.Key), ".")] = value.Any()
+}
+
+func fromSlogLevel(level slog.Level) model.Level {
+	switch {
+	case level >= model.LevelFatal:
+		return model.FatalLevel
+	case level >= slog.LevelError:
+		return model.ErrorLevel
+	case level >= slog.LevelWarn:
+		return model.WarnLevel
+	case level >= slog.LevelInfo:
+		return model.InfoLevel
+	default:
+		return model.DebugLevel
+	}
+}
@@ -0,0 +1,248 @@
+// Package logtest provides a model.Logger recording structured entries in memory, so that tests can
+// assert on what code logged rather than parse the output of a real logger.
+package logtest
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+// errorKey is the field holding the error set with WithError, as with the logger package.
+const errorKey = "error"
+
+// TestingT is the subset of testing.TB used by the assertion helpers.
+type TestingT interface {
+	Errorf(format string, args ...interface{})
+	Helper()
+}
+
+// Entry is a recorded log message.
+type Entry struct {
+	Level   model.Level
+	Message string
+	// Fields are the fields of the logger and, for messages logged through ToKeyValLogger, the key-value
+	// pairs of the message. Groups are flattened into dotted keys, and values are stored as slog stores
+	// them, e.g. int as int64.
+	Fields model.Fields
+	// Err is the error set with WithError, which is not part of Fields.
+	Err error
+}
+
+// String formats the entry on one line with its fields sorted by key.
+func (e Entry) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%-5s %s", e.Level, e.Message)
+	keys := make([]string, 0, len(e.Fields))
+	for key := range e.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Fprintf(&b, " %s=%v", key, e.Fields[key])
+	}
+	if e.Err != nil {
+		fmt.Fprintf(&b, " %s=%q", errorKey, e.Err.Error())
+	}
+	return b.String()
+}
+
+// recorder holds the entries of a logger and of all the loggers derived from it.
+type recorder struct {
+	mu      sync.Mutex
+	level   model.Level
+	entries []Entry
+	tb      testing.TB
+	done    bool
+}
+
+// Logger is a model.Logger recording entries instead of writing them. Loggers derived with WithField,
+// WithFields and WithError record to the same entries. Fatal is recorded without exiting.
+// Logger is safe for concurrent use.
+type Logger struct {
+	rec    *recorder
+	fields model.Fields
+	err    error
+}
+
+var (
+	_ model.Logger      = (*Logger)(nil)
+	_ model.LevelSetter = (*Logger)(nil)
+)
+
+// New returns a Logger recording messages of every level.
+func New() *Logger {
+	return &Logger{rec: &recorder{level: model.DebugLevel}}
+}
+
+// NewTB returns a Logger that also writes each entry to tb.Log, so that the logs of a test are shown
+// along with its failures or with go test -v. Entries logged after the test completed are only recorded.
+func NewTB(tb testing.TB) *Logger {
+	log := New()
+	log.rec.tb = tb
+	tb.Cleanup(func() {
+		log.rec.mu.Lock()
+		defer log.rec.mu.Unlock()
+		log.rec.done = true
+	})
+	return log
+}
+
+func (l *Logger) Debug(msg string) { l.log(model.DebugLevel, msg, nil) }
+func (l *Logger) Info(msg string)  { l.log(model.InfoLevel, msg, nil) }
+func (l *Logger) Warn(msg string)  { l.log(model.WarnLevel, msg, nil) }
+func (l *Logger) Error(msg string) { l.log(model.ErrorLevel, msg, nil) }
+func (l *Logger) Fatal(msg string) { l.log(model.FatalLevel, msg, nil) }
+
+func (l *Logger) DebugCtx(_ context.Context, msg string) { l.Debug(msg) }
+func (l *Logger) InfoCtx(_ context.Context, msg string)  { l.Info(msg) }
+func (l *Logger) WarnCtx(_ context.Context, msg string)  { l.Warn(msg) }
+func (l *Logger) ErrorCtx(_ context.Context, msg string) { l.Error(msg) }
+func (l *Logger) FatalCtx(_ context.Context, msg string) { l.Fatal(msg) }
+
+//nolint:ireturn // implements model.Logger interface
+func (l *Logger) WithField(key string, value interface{}) model.Logger {
+	return l.with(model.Fields{key: value})
+}
+
+//nolint:ireturn // implements model.Logger interface
+func (l *Logger) WithFields(fields model.Fields) model.Logger {
+	return l.with(fields)
+}
+
+//nolint:ireturn // implements model.Logger interface
+func (l *Logger) WithError(err error) model.Logger {
+	return &Logger{rec: l.rec, fields: l.fields, err: err}
+}
+
+// ToKeyValLogger returns a *slog.Logger recording to the same entries.
+//
+//nolint:ireturn // implements model.Logger interface
+func (l *Logger) ToKeyValLogger() model.KeyValLogger {
+	return slog.New(&handler{log: l})
+}
+
+// SetLevel sets the lowest level recorded. The default level is DEBUG.
+func (l *Logger) SetLevel(level model.Level) {
+	l.rec.mu.Lock()
+	defer l.rec.mu.Unlock()
+	l.rec.level = level
+}
+
+func (l *Logger) GetLevel() model.Level {
+	l.rec.mu.Lock()
+	defer l.rec.mu.Unlock()
+	return l.rec.level
+}
+
+// Entries returns a copy of the recorded entries, oldest first.
+func (l *Logger) Entries() []Entry {
+	l.rec.mu.Lock()
+	defer l.rec.mu.Unlock()
+	return append([]Entry(nil), l.rec.entries...)
+}
+
+// Find returns the recorded entries with the message msg.
+func (l *Logger) Find(msg string) []Entry {
+	var found []Entry
+	for _, entry := range l.Entries() {
+		if entry.Message == msg {
+			found = append(found, entry)
+		}
+	}
+	return found
+}
+
+// Reset drops the recorded entries.
+func (l *Logger) Reset() {
+	l.rec.mu.Lock()
+	defer l.rec.mu.Unlock()
+	l.rec.entries = nil
+}
+
+// AssertLogged checks that an entry was recorded with level and msg, and with at least the given fields,
+// compared with reflect.DeepEqual. The "error" field is compared with the message of the entry error.
+func (l *Logger) AssertLogged(t TestingT, level model.Level, msg string, fields model.Fields) bool {
+	t.Helper()
+	for _, entry := range l.Entries() {
+		if entry.Level == level && entry.Message == msg && entry.hasFields(fields) {
+			return true
+		}
+	}
+	t.Errorf("no %s entry %q with fields %v in:\n%s", level, msg, fields, l.dump())
+	return false
+}
+
+// AssertNotLogged checks that no entry was recorded with level and msg.
+func (l *Logger) AssertNotLogged(t TestingT, level model.Level, msg string) bool {
+	t.Helper()
+	for _, entry := range l.Entries() {
+		if entry.Level == level && entry.Message == msg {
+			t.Errorf("unexpected entry: %s", entry)
+			return false
+		}
+	}
+	return true
+}
+
+func (l *Logger) with(fields model.Fields) *Logger {
+	merged := make(model.Fields, len(l.fields)+len(fields))
+	for key, value := range l.fields {
+		merged[key] = value
+	}
+	for key, value := range fields {
+		merged[key] = value
+	}
+	return &Logger{rec: l.rec, fields: merged, err: l.err}
+}
+
+func (l *Logger) log(level model.Level, msg string, fields model.Fields) {
+	entry := Entry{Level: level, Message: msg, Fields: make(model.Fields, len(l.fields)+len(fields)), Err: l.err}
+	for key, value := range l.fields {
+		entry.Fields[key] = value
+	}
+	for key, value := range fields {
+		entry.Fields[key] = value
+	}
+
+	l.rec.mu.Lock()
+	defer l.rec.mu.Unlock()
+	if level < l.rec.level {
+		return
+	}
+	l.rec.entries = append(l.rec.entries, entry)
+	if l.rec.tb != nil && !l.rec.done {
+		l.rec.tb.Helper()
+		l.rec.tb.Log(entry.String())
+	}
+}
+
+func (l *Logger) dump() string {
+	var b strings.Builder
+	for _, entry := range l.Entries() {
+		b.WriteString("\t" + entry.String() + "\n")
+	}
+	return b.String()
+}
+
+func (e Entry) hasFields(fields model.Fields) bool {
+	for key, want := range fields {
+		if key == errorKey && e.Err != nil {
+			if message, ok := want.(string); ok && message == e.Err.Error() {
+				continue
+			}
+		}
+		got, ok := e.Fields[key]
+		if !ok || !reflect.DeepEqual(got, want) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,151 @@
+package logtest_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger/logtest"
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+var errDeclined = errors.New("card declined")
+
+// fakeT records the failures of assertion helpers.
+type fakeT struct {
+	errors []string
+}
+
+func (f *fakeT) Errorf(format string, args ...interface{}) {
+	f.errors = append(f.errors, fmt.Sprintf(format, args...))
+}
+
+func (f *fakeT) Helper() {}
+
+func TestLogger(t *testing.T) {
+	t.Parallel()
+	log := logtest.New()
+	charge := log.WithField("order", 42)
+
+	charge.Info("charging")
+	charge.WithFields(model.Fields{"attempt": 2}).WithError(errDeclined).Warn("charge failed")
+	log.Debug("retrying")
+
+	require.Equal(t, []logtest.Entry{
+		{Level: model.InfoLevel, Message: "charging", Fields: model.Fields{"order": 42}},
+		{Level: model.WarnLevel, Message: "charge failed", Fields: model.Fields{"order": 42, "attempt": 2}, Err: errDeclined},
+		{Level: model.DebugLevel, Message: "retrying", Fields: model.Fields{}},
+	}, log.Entries())
+	assert.Len(t, log.Find("charging"), 1)
+	assert.Equal(t, `WARN  charge failed attempt=2 order=42 error="card declined"`, log.Entries()[1].String())
+
+	log.Reset()
+	assert.Empty(t, log.Entries())
+}
+
+func TestLogger_Assertions(t *testing.T) {
+	t.Parallel()
+	log := logtest.New()
+	log.WithField("order", 42).WithError(errDeclined).Error("charge failed")
+
+	tests := []struct {
+		name   string
+		assert func(t logtest.TestingT) bool
+		want   bool
+	}{
+		{
+			name: "logged",
+			assert: func(t logtest.TestingT) bool {
+				return log.AssertLogged(t, model.ErrorLevel, "charge failed", model.Fields{"order": 42})
+			},
+			want: true,
+		},
+		{
+			name: "logged with error",
+			assert: func(t logtest.TestingT) bool {
+				return log.AssertLogged(t, model.ErrorLevel, "charge failed", model.Fields{"error": "card declined"})
+			},
+			want: true,
+		},
+		{
+			name: "other level",
+			assert: func(t logtest.TestingT) bool {
+				return log.AssertLogged(t, model.InfoLevel, "charge failed", nil)
+			},
+		},
+		{
+			name: "other field value",
+			assert: func(t logtest.TestingT) bool {
+				return log.AssertLogged(t, model.ErrorLevel, "charge failed", model.Fields{"order": 43})
+			},
+		},
+		{
+			name: "not logged",
+			assert: func(t logtest.TestingT) bool {
+				return log.AssertNotLogged(t, model.InfoLevel, "charge failed")
+			},
+			want: true,
+		},
+		{
+			name: "unexpectedly logged",
+			assert: func(t logtest.TestingT) bool {
+				return log.AssertNotLogged(t, model.ErrorLevel, "charge failed")
+			},
+		},
+	}
+	for _, tC := range tests {
+		tt := tC
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ft := &fakeT{}
+			assert.Equal(t, tt.want, tt.assert(ft))
+			assert.Equal(t, tt.want, len(ft.errors) == 0, ft.errors)
+		})
+	}
+}
+
+func TestLogger_SetLevel(t *testing.T) {
+	t.Parallel()
+	log := logtest.New()
+	log.SetLevel(model.WarnLevel)
+
+	log.Info("dropped")
+	log.ToKeyValLogger().Info("dropped")
+	log.Warn("kept")
+	log.FatalCtx(context.Background(), "kept")
+
+	assert.Equal(t, model.WarnLevel, log.GetLevel())
+	assert.Len(t, log.Entries(), 2)
+	assert.Empty(t, log.Find("dropped"))
+}
+
+func TestLogger_ToKeyValLogger(t *testing.T) {
+	t.Parallel()
+	log := logtest.New()
+	entry, ok := log.WithField("service", "billing").ToKeyValLogger().(*slog.Logger)
+	require.True(t, ok)
+
+	entry.With("order", 42).WithGroup("card").Warn("charge failed", "brand", "visa", slog.Group("issuer", "country", "FR"))
+	entry.Error("charge failed", "error", errDeclined)
+
+	log.AssertLogged(t, model.WarnLevel, "charge failed", model.Fields{
+		"service":             "billing",
+		"order":               int64(42),
+		"card.brand":          "visa",
+		"card.issuer.country": "FR",
+	})
+	log.AssertLogged(t, model.ErrorLevel, "charge failed", model.Fields{"error": "card declined"})
+	assert.Equal(t, errDeclined, log.Entries()[1].Err)
+}
+
+func TestNewTB(t *testing.T) {
+	t.Parallel()
+	log := logtest.NewTB(t)
+	log.WithField("order", 42).Info("logged to t.Log")
+	log.AssertLogged(t, model.InfoLevel, "logged to t.Log", model.Fields{"order": 42})
+}
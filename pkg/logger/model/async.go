@@ -0,0 +1,72 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// DefaultAsyncQueueSize is the number of queued messages when AsyncConfig.QueueSize is not set.
+const DefaultAsyncQueueSize = 1024
+
+// ErrUnknownOverflowPolicy is returned by ParseOverflowPolicy for strings that are not an overflow policy.
+var ErrUnknownOverflowPolicy = errors.New("unknown overflow policy")
+
+// An OverflowPolicy is what an asynchronous logger does with a message when its queue is full.
+type OverflowPolicy uint8
+
+const (
+	// OverflowBlock waits for room in the queue, so that no message is lost. It is the default policy.
+	OverflowBlock OverflowPolicy = iota
+
+	// OverflowDropNewest drops the message being logged.
+	OverflowDropNewest
+
+	// OverflowDropOldest drops the oldest queued message to make room for the message being logged.
+	OverflowDropOldest
+)
+
+func (p OverflowPolicy) String() string {
+	switch p {
+	case OverflowBlock:
+		return "block"
+	case OverflowDropNewest:
+		return "drop_newest"
+	case OverflowDropOldest:
+		return "drop_oldest"
+	default:
+		return fmt.Sprintf("OverflowPolicy(%d)", uint8(p))
+	}
+}
+
+// ParseOverflowPolicy converts block, drop_newest or drop_oldest to an overflow policy, case-insensitively.
+// The empty string is block.
+func ParseOverflowPolicy(policy string) (OverflowPolicy, error) {
+	switch strings.ToLower(policy) {
+	case "", "block":
+		return OverflowBlock, nil
+	case "drop_newest":
+		return OverflowDropNewest, nil
+	case "drop_oldest":
+		return OverflowDropOldest, nil
+	default:
+		return 0, fmt.Errorf("%w %q", ErrUnknownOverflowPolicy, policy)
+	}
+}
+
+// AsyncConfig writes log messages from a background goroutine, so that logging does not wait for
+// a slow output. Messages are queued in order and the queue is drained by Flush and Close.
+type AsyncConfig struct {
+	// QueueSize is the number of messages waiting to be written. The default size is 1024.
+	QueueSize int
+
+	// Overflow is what happens to messages logged while the queue is full. The default policy blocks.
+	Overflow OverflowPolicy
+}
+
+func (c *AsyncConfig) GetQueueSize() int {
+	if c.QueueSize <= 0 {
+		return DefaultAsyncQueueSize
+	}
+	return c.QueueSize
+}
@@ -0,0 +1,43 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+func TestParseOverflowPolicy(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		policy  string
+		want    model.OverflowPolicy
+		wantErr bool
+	}{
+		{name: "empty", policy: "", want: model.OverflowBlock},
+		{name: "block", policy: "block", want: model.OverflowBlock},
+		{name: "drop newest", policy: "Drop_Newest", want: model.OverflowDropNewest},
+		{name: "drop oldest", policy: "drop_oldest", want: model.OverflowDropOldest},
+		{name: "unknown", policy: "drop", wantErr: true},
+	}
+	for _, tC := range tests {
+		tt := tC
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			policy, err := model.ParseOverflowPolicy(tt.policy)
+			if tt.wantErr {
+				require.ErrorIs(t, err, model.ErrUnknownOverflowPolicy)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, policy)
+			if tt.policy != "" {
+				assert.Equal(t, strings.ToLower(tt.policy), policy.String())
+			}
+		})
+	}
+}
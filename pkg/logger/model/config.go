@@ -1,10 +1,37 @@
 package model
 
 import (
+	"errors"
+	"fmt"
 	"io"
 	"log/slog"
+	"regexp"
+	"time"
 )
 
+// DefaultExitHookTimeout is the time given to exit hooks when Config.ExitHookTimeout is not set.
+const DefaultExitHookTimeout = 5 * time.Second
+
+// A FatalAction is what Fatal does after logging.
+type FatalAction uint8
+
+const (
+	// FatalExit exits the process with status 1.
+	FatalExit FatalAction = iota
+
+	// FatalPanic panics, so that tests can recover from Fatal and check what was logged.
+	FatalPanic
+)
+
+// ErrMissingOutput is returned by Config.Validate for sinks without output.
+var ErrMissingOutput = errors.New("missing output")
+
+// DefaultRedactionMask replaces redacted values when RedactionConfig.Mask is not set.
+const DefaultRedactionMask = "[REDACTED]"
+
+// DefaultSamplingInterval is the sampling period used when SamplingConfig.Interval is not set.
+const DefaultSamplingInterval = time.Second
+
 // Config is a logging configuration.
 type Config struct {
 	// Level is the lowest level of log message that should be emitted. Any log
@@ -17,6 +44,123 @@ type Config struct {
 
 	// IncludeSource specifies whether to add source in the output. Default is false.
 	IncludeSource bool
+
+	// Format is the encoding of log messages: json, text or console. The default format is json.
+	Format string
+
+	// Sampling limits repeated log messages. Sampling is disabled when nil.
+	Sampling *SamplingConfig
+
+	// Redaction masks sensitive attributes. Redaction is disabled when nil.
+	Redaction *RedactionConfig
+
+	// Sinks fan log messages out to several destinations. When set, Output and Format are ignored.
+	// Without Async, the sinks are written one after another by the caller, so a blocking sink holds up the
+	// other sinks and the caller.
+	Sinks []SinkConfig
+
+	// Async writes log messages from a background goroutine. Messages are written synchronously when nil.
+	// Each sink has its own queue, so that a blocked sink only delays its own messages.
+	Async *AsyncConfig
+
+	// ComponentLevels are the levels of named loggers by name, e.g. {"orchestrator.temporal": "debug"}.
+	// The level of a name also applies to the loggers named after it, such as orchestrator.temporal.sdk,
+	// unless they have their own. Other loggers use Level.
+	ComponentLevels map[string]string
+
+	// FatalAction is what Fatal does once the message is logged and the exit hooks ran: exit the process,
+	// the default, or panic, e.g. in tests.
+	FatalAction FatalAction
+
+	// ExitFunc exits the process after Fatal with FatalExit. The default is os.Exit.
+	ExitFunc func(code int)
+
+	// ExitHookTimeout bounds the time given to the exit hooks before Fatal exits. The default timeout is 5 seconds.
+	ExitHookTimeout time.Duration
+
+	// ContextExtractors add attributes from the context to messages logged with a context,
+	// e.g. with InfoCtx.
+	ContextExtractors []ContextExtractor
+
+	// KeepSlogDefault leaves the default slog logger, which also writes the output of the log package,
+	// unchanged. By default the new logger becomes the default, which only suits the logger of the process.
+	KeepSlogDefault bool
+}
+
+// SinkConfig is a destination of log messages with its own format and minimum level.
+type SinkConfig struct {
+	// Output is the destination for log messages.
+	Output io.Writer
+
+	// Format is the encoding of log messages: json, text or console. The default format is json.
+	Format string
+
+	// Level is the lowest level emitted by the sink. Messages must also pass the logger level,
+	// so changing the logger level with SetLevel applies to every sink. Empty means the logger level only.
+	Level string
+}
+
+// Validate returns an error for every invalid level and format of the configuration, its sinks and
+// its component levels. NewSlogLoggerE returns it, while NewSlogLogger falls back to INFO and json for such
+// values and logs it as a warning.
+func (c *Config) Validate() error {
+	var errs []error
+	if c.Level != "" {
+		if _, err := ParseLevelStrict(c.Level); err != nil {
+			errs = append(errs, fmt.Errorf("level: %w", err))
+		}
+	}
+	if _, err := ParseFormatStrict(c.Format); err != nil {
+		errs = append(errs, fmt.Errorf("format: %w", err))
+	}
+	for name, level := range c.ComponentLevels {
+		if _, err := ParseLevelStrict(level); err != nil {
+			errs = append(errs, fmt.Errorf("component %s: %w", name, err))
+		}
+	}
+	for i, sink := range c.Sinks {
+		if sink.Output == nil {
+			errs = append(errs, fmt.Errorf("sink %d: %w", i, ErrMissingOutput))
+		}
+		if sink.Level != "" {
+			if _, err := ParseLevelStrict(sink.Level); err != nil {
+				errs = append(errs, fmt.Errorf("sink %d level: %w", i, err))
+			}
+		}
+		if _, err := ParseFormatStrict(sink.Format); err != nil {
+			errs = append(errs, fmt.Errorf("sink %d format: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (c *Config) GetExitHookTimeout() time.Duration {
+	if c.ExitHookTimeout <= 0 {
+		return DefaultExitHookTimeout
+	}
+	return c.ExitHookTimeout
+}
+
+func (c *SinkConfig) GetFormat() Format {
+	return ParseFormat(c.Format)
+}
+
+// SamplingConfig limits repeated log messages, e.g. the same error logged by every retry of an activity.
+// Within each interval, the first Initial messages with the same level and text are logged, then every
+// Thereafter-th one. The number of dropped messages is logged when the interval ends and when the logger is
+// flushed or closed.
+type SamplingConfig struct {
+	// Initial is the number of messages logged per level and text in each interval.
+	Initial int
+
+	// Thereafter logs every Thereafter-th message once Initial is reached. Zero drops them all.
+	Thereafter int
+
+	// Interval is the sampling period. The default interval is one second.
+	Interval time.Duration
+
+	// ExemptErrors logs every ERROR and FATAL message regardless of sampling.
+	ExemptErrors bool
 }
 
 func (c *Config) GetLevel() Level {
@@ -26,3 +170,56 @@ func (c *Config) GetLevel() Level {
 func (c *Config) GetSlogLevel() slog.Level {
 	return c.GetLevel().SlogLevel()
 }
+
+func (c *Config) GetFormat() Format {
+	return ParseFormat(c.Format)
+}
+
+// RedactionConfig masks sensitive data in log attributes, including attributes nested in groups,
+// maps and structs. Values implementing Redactor are replaced by their redacted form.
+type RedactionConfig struct {
+	// Keys are attribute, map key and struct field names whose values are masked, compared case-insensitively.
+	Keys []string
+
+	// KeyPatterns mask the values of the keys they match.
+	KeyPatterns []*regexp.Regexp
+
+	// ValuePatterns mask the parts of string values and messages they match.
+	ValuePatterns []*regexp.Regexp
+
+	// Mask replaces redacted values. The default mask is [REDACTED].
+	Mask string
+}
+
+func (c *RedactionConfig) GetMask() string {
+	if c.Mask == "" {
+		return DefaultRedactionMask
+	}
+	return c.Mask
+}
+
+func (c *SamplingConfig) GetInterval() time.Duration {
+	if c.Interval <= 0 {
+		return DefaultSamplingInterval
+	}
+	return c.Interval
+}
+
+// FileConfig configures a rotating log file.
+// The current file is renamed to a timestamped backup when it exceeds MaxSize or when Interval elapses.
+type FileConfig struct {
+	// Filename is the path of the current log file. Backups are written to the same directory.
+	Filename string
+
+	// MaxSize is the size in bytes after which the file is rotated. Zero disables rotation by size.
+	MaxSize int64
+
+	// Interval is the age after which the file is rotated. Zero disables rotation by time.
+	Interval time.Duration
+
+	// MaxBackups is the number of backups to keep. Zero keeps all backups.
+	MaxBackups int
+
+	// Compress gzips backups.
+	Compress bool
+}
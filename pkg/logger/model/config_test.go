@@ -1,8 +1,12 @@
 package model_test
 
 import (
+	"io"
 	"log/slog"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
 
 	logModel "github.com/nash-567/goTemporalLoom/pkg/logger/model"
 )
@@ -62,3 +66,80 @@ func TestConfig_GetSlogLevel(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_GetFormat(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		format string
+		want   logModel.Format
+	}{
+		{name: "default", format: "", want: logModel.FormatJSON},
+		{name: "json", format: "json", want: logModel.FormatJSON},
+		{name: "text", format: "TEXT", want: logModel.FormatText},
+		{name: "console", format: "console", want: logModel.FormatConsole},
+		{name: "invalid", format: "invalid", want: logModel.FormatJSON},
+	}
+	for _, tC := range tests {
+		tt := tC
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := &logModel.Config{
+				Format: tt.format,
+			}
+			if got := c.GetFormat(); got != tt.want {
+				t.Errorf("GetFormat() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSamplingConfig_GetInterval(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, logModel.DefaultSamplingInterval, (&logModel.SamplingConfig{}).GetInterval())
+	assert.Equal(t, time.Minute, (&logModel.SamplingConfig{Interval: time.Minute}).GetInterval())
+}
+
+func TestConfig_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		config  *logModel.Config
+		wantErr []string
+	}{
+		{name: "defaults", config: &logModel.Config{}},
+		{
+			name: "valid",
+			config: &logModel.Config{
+				Level:           "DEBUG",
+				Format:          "console",
+				ComponentLevels: map[string]string{"temporal": "warn"},
+				Sinks:           []logModel.SinkConfig{{Output: io.Discard, Format: "text", Level: "error"}},
+			},
+		},
+		{
+			name: "invalid",
+			config: &logModel.Config{
+				Level:           "verbose",
+				Format:          "xml",
+				ComponentLevels: map[string]string{"temporal": "loud"},
+				Sinks:           []logModel.SinkConfig{{Format: "yaml", Level: "all"}},
+			},
+			wantErr: []string{"level", "format", "component temporal", "sink 0: missing output", "sink 0 level", "sink 0 format"},
+		},
+	}
+	for _, tC := range tests {
+		tt := tC
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.config.Validate()
+			if len(tt.wantErr) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			for _, want := range tt.wantErr {
+				assert.ErrorContains(t, err, want)
+			}
+		})
+	}
+}
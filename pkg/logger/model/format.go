@@ -0,0 +1,53 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUnknownFormat is returned by ParseFormatStrict for strings that are not a format.
+var ErrUnknownFormat = errors.New("unknown log format")
+
+// A Format is the encoding of log entries.
+type Format string
+
+const (
+	// FormatJSON emits one JSON object per entry. It is the default format.
+	FormatJSON Format = "json"
+
+	// FormatText emits one line of logfmt key=value pairs per entry.
+	FormatText Format = "text"
+
+	// FormatConsole emits colored, human-friendly lines for local development.
+	FormatConsole Format = "console"
+)
+
+// ParseFormat converts a format string to a format constant
+//
+//	if the wrong string received it returns json format.
+func ParseFormat(format string) Format {
+	switch Format(strings.ToLower(format)) {
+	case FormatText:
+		return FormatText
+	case FormatConsole:
+		return FormatConsole
+	default:
+		return FormatJSON
+	}
+}
+
+// ParseFormatStrict converts a format string to a format constant, case-insensitively.
+// Unlike ParseFormat, it returns ErrUnknownFormat for the wrong string; the empty string is json.
+func ParseFormatStrict(format string) (Format, error) {
+	switch Format(strings.ToLower(format)) {
+	case "", FormatJSON:
+		return FormatJSON, nil
+	case FormatText:
+		return FormatText, nil
+	case FormatConsole:
+		return FormatConsole, nil
+	default:
+		return "", fmt.Errorf("%w %q", ErrUnknownFormat, format)
+	}
+}
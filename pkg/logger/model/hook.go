@@ -0,0 +1,64 @@
+package model
+
+import (
+	"net/http"
+	"time"
+)
+
+// DefaultWebhookTimeout bounds each request of a webhook when WebhookConfig.Timeout is not set.
+const DefaultWebhookTimeout = 5 * time.Second
+
+// DefaultDedupWindow is the window of a deduplicating hook when DedupConfig.Window is not set.
+const DefaultDedupWindow = time.Minute
+
+// WebhookConfig posts log messages as JSON to an HTTP endpoint, such as the intake of an error tracker.
+type WebhookConfig struct {
+	// URL is the endpoint the messages are posted to.
+	URL string
+
+	// Headers are added to each request, e.g. an Authorization header.
+	Headers map[string]string
+
+	// Levels are the levels of the messages posted. The default levels are ERROR and FATAL.
+	Levels []Level
+
+	// Timeout bounds each request. The default timeout is 5 seconds.
+	Timeout time.Duration
+
+	// Client sends the requests. The default client is http.DefaultClient.
+	Client *http.Client
+}
+
+func (c *WebhookConfig) GetLevels() []Level {
+	if len(c.Levels) == 0 {
+		return []Level{ErrorLevel, FatalLevel}
+	}
+	return c.Levels
+}
+
+func (c *WebhookConfig) GetTimeout() time.Duration {
+	if c.Timeout <= 0 {
+		return DefaultWebhookTimeout
+	}
+	return c.Timeout
+}
+
+func (c *WebhookConfig) GetClient() *http.Client {
+	if c.Client == nil {
+		return http.DefaultClient
+	}
+	return c.Client
+}
+
+// DedupConfig groups identical log messages, with the same level, message and error, within a window.
+type DedupConfig struct {
+	// Window is the period during which repeated messages are grouped. The default window is one minute.
+	Window time.Duration
+}
+
+func (c *DedupConfig) GetWindow() time.Duration {
+	if c.Window <= 0 {
+		return DefaultDedupWindow
+	}
+	return c.Window
+}
@@ -1,5 +1,7 @@
 package model
 
+import "context"
+
 // A Logger provides methods for logging messages.
 type Logger interface {
 	// Debug emits a "DEBUG" level log message.
@@ -26,6 +28,21 @@ type Logger interface {
 	// Fatal emits a "FATAL" level log message.
 	Fatal(msg string)
 
+	// DebugCtx emits a "DEBUG" level log message with the attributes extracted from ctx.
+	DebugCtx(ctx context.Context, msg string)
+
+	// InfoCtx emits an "INFO" level log message with the attributes extracted from ctx.
+	InfoCtx(ctx context.Context, msg string)
+
+	// WarnCtx emits a "WARN" level log message with the attributes extracted from ctx.
+	WarnCtx(ctx context.Context, msg string)
+
+	// ErrorCtx emits an "ERROR" level log message with the attributes extracted from ctx.
+	ErrorCtx(ctx context.Context, msg string)
+
+	// FatalCtx emits a "FATAL" level log message with the attributes extracted from ctx.
+	FatalCtx(ctx context.Context, msg string)
+
 	// ToKeyValLogger returns an instance of logger which implements KeyValLogger interface
 	// this can be used to pass an instance of logger to libraries which require KeyValLogger.
 	ToKeyValLogger() KeyValLogger
@@ -40,6 +57,12 @@ type KeyValLogger interface {
 
 //go:generate mockery --name=Logger --outpkg mocks
 
+// Redactor is implemented by types holding sensitive data. When redaction is enabled, the logger
+// logs the value returned by Redact instead of the value itself.
+type Redactor interface {
+	Redact() interface{}
+}
+
 type LevelSetter interface {
 	SetLevel(Level)
 	GetLevel() Level
@@ -1,6 +1,8 @@
 package model
 
 import (
+	"errors"
+	"fmt"
 	"log/slog"
 	"strings"
 )
@@ -35,8 +37,8 @@ const (
 	ErrorLevel
 
 	// FatalLevel causes a logger to emit messages logged at "FATAL" level or more
-	// severe. Messages logged at this level cause a logger to log the message and
-	// then call os.Exit(1).
+	// severe. Messages logged at this level cause a logger to log the message,
+	// run its exit hooks and then exit, see Config.FatalAction.
 	FatalLevel
 )
 
@@ -58,23 +60,36 @@ func (l Level) String() string {
 	}
 }
 
+// ErrUnknownLevel is returned by ParseLevelStrict for strings that are not a level.
+var ErrUnknownLevel = errors.New("unknown log level")
+
 // ParseLevel converts log level string to level constant
 //
 //	if the wrong string received it returns info level.
 func ParseLevel(logLevel string) Level {
+	level, err := ParseLevelStrict(logLevel)
+	if err != nil {
+		return InfoLevel
+	}
+	return level
+}
+
+// ParseLevelStrict converts log level string to level constant, case-insensitively.
+// Unlike ParseLevel, it returns ErrUnknownLevel for the wrong string.
+func ParseLevelStrict(logLevel string) (Level, error) {
 	switch strings.ToLower(logLevel) {
 	case "debug":
-		return DebugLevel
+		return DebugLevel, nil
 	case "info":
-		return InfoLevel
+		return InfoLevel, nil
 	case "warn":
-		return WarnLevel
+		return WarnLevel, nil
 	case "error":
-		return ErrorLevel
+		return ErrorLevel, nil
 	case "fatal":
-		return FatalLevel
+		return FatalLevel, nil
 	default:
-		return InfoLevel
+		return 0, fmt.Errorf("%w %q", ErrUnknownLevel, logLevel)
 	}
 }
 
@@ -94,3 +109,25 @@ func (l Level) SlogLevel() slog.Level {
 		return slog.LevelInfo
 	}
 }
+
+// ParseComponentLevels parses the levels of named loggers from a comma-separated list of name=level pairs,
+// e.g. "orchestrator.temporal=debug,orchestrator.temporal.sdk=warn", as used for Config.ComponentLevels.
+func ParseComponentLevels(spec string) (map[string]string, error) {
+	levels := make(map[string]string)
+	for _, pair := range strings.Split(spec, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		name, level, ok := strings.Cut(pair, "=")
+		name, level = strings.TrimSpace(name), strings.TrimSpace(level)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid component level %q, expected name=level", pair)
+		}
+		if _, err := ParseLevelStrict(level); err != nil {
+			return nil, fmt.Errorf("component %s: %w", name, err)
+		}
+		levels[name] = level
+	}
+	return levels, nil
+}
@@ -1,7 +1,9 @@
 package model_test
 
 import (
+	"errors"
 	"log/slog"
+	"reflect"
 	"testing"
 
 	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
@@ -80,3 +82,70 @@ func TestSlogLevel(t *testing.T) {
 		})
 	}
 }
+
+func TestParseLevelStrict(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		desc    string
+		sent    string
+		want    model.Level
+		wantErr bool
+	}{
+		{desc: "empty string", sent: "", wantErr: true},
+		{desc: "incorrect string", sent: "test", wantErr: true},
+		{desc: "upper case", sent: "DEBUG", want: model.DebugLevel},
+		{desc: "fatal string", sent: "fatal", want: model.FatalLevel},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			t.Parallel()
+			lvl, err := model.ParseLevelStrict(tC.sent)
+			if tC.wantErr {
+				if !errors.Is(err, model.ErrUnknownLevel) {
+					t.Errorf("unexpected error %v", err)
+				}
+				return
+			}
+			if err != nil || lvl != tC.want {
+				t.Errorf("unexpected level %v, %v", lvl, err)
+			}
+		})
+	}
+}
+
+func TestParseComponentLevels(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		desc    string
+		sent    string
+		want    map[string]string
+		wantErr bool
+	}{
+		{desc: "empty string", sent: "", want: map[string]string{}},
+		{
+			desc: "levels",
+			sent: "orchestrator.temporal=debug, orchestrator.temporal.sdk = WARN,",
+			want: map[string]string{"orchestrator.temporal": "debug", "orchestrator.temporal.sdk": "WARN"},
+		},
+		{desc: "missing level", sent: "orchestrator", wantErr: true},
+		{desc: "missing name", sent: "=debug", wantErr: true},
+		{desc: "unknown level", sent: "orchestrator=verbose", wantErr: true},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			t.Parallel()
+			levels, err := model.ParseComponentLevels(tC.sent)
+			if tC.wantErr {
+				if err == nil {
+					t.Error("expected error")
+				}
+				return
+			}
+			if err != nil || !reflect.DeepEqual(levels, tC.want) {
+				t.Errorf("unexpected levels %v, %v", levels, err)
+			}
+		})
+	}
+}
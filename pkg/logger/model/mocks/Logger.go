@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	model "github.com/nash-567/goTemporalLoom/pkg/logger/model"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -17,21 +19,41 @@ func (_m *Logger) Debug(msg string) {
 	_m.Called(msg)
 }
 
+// DebugCtx provides a mock function with given fields: ctx, msg
+func (_m *Logger) DebugCtx(ctx context.Context, msg string) {
+	_m.Called(ctx, msg)
+}
+
 // Error provides a mock function with given fields: msg
 func (_m *Logger) Error(msg string) {
 	_m.Called(msg)
 }
 
+// ErrorCtx provides a mock function with given fields: ctx, msg
+func (_m *Logger) ErrorCtx(ctx context.Context, msg string) {
+	_m.Called(ctx, msg)
+}
+
 // Fatal provides a mock function with given fields: msg
 func (_m *Logger) Fatal(msg string) {
 	_m.Called(msg)
 }
 
+// FatalCtx provides a mock function with given fields: ctx, msg
+func (_m *Logger) FatalCtx(ctx context.Context, msg string) {
+	_m.Called(ctx, msg)
+}
+
 // Info provides a mock function with given fields: msg
 func (_m *Logger) Info(msg string) {
 	_m.Called(msg)
 }
 
+// InfoCtx provides a mock function with given fields: ctx, msg
+func (_m *Logger) InfoCtx(ctx context.Context, msg string) {
+	_m.Called(ctx, msg)
+}
+
 // ToKeyValLogger provides a mock function with given fields:
 func (_m *Logger) ToKeyValLogger() model.KeyValLogger {
 	ret := _m.Called()
@@ -57,6 +79,11 @@ func (_m *Logger) Warn(msg string) {
 	_m.Called(msg)
 }
 
+// WarnCtx provides a mock function with given fields: ctx, msg
+func (_m *Logger) WarnCtx(ctx context.Context, msg string) {
+	_m.Called(ctx, msg)
+}
+
 // WithError provides a mock function with given fields: err
 func (_m *Logger) WithError(err error) model.Logger {
 	ret := _m.Called(err)
@@ -1,7 +1,11 @@
 package mocks
 
 // Code generated by (wrapping method of mock logger)
-import "github.com/CarringtonLabs/cl-backend-core/pkg/logger/model"
+import (
+	"context"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
 
 // MockLogger is a wrapper of mock type for the Logger type.
 type MockLogger struct {
@@ -9,41 +13,71 @@ type MockLogger struct {
 }
 
 // Debug provides a mock function with given fields: msg.
-func (_m MockLogger) Debug(msg string) {
+func (_m *MockLogger) Debug(msg string) {
 	_m.Logger.Debug(msg)
 }
 
 // Error provides a mock function with given fields: msg.
-func (_m MockLogger) Error(msg string) {
+func (_m *MockLogger) Error(msg string) {
 	_m.Logger.Error(msg)
 }
 
+// Fatal provides a mock function with given fields: msg.
+func (_m *MockLogger) Fatal(msg string) {
+	_m.Logger.Fatal(msg)
+}
+
 // Info provides a mock function with given fields: msg.
-func (_m MockLogger) Info(msg string) {
+func (_m *MockLogger) Info(msg string) {
 	_m.Logger.Info(msg)
 }
 
 // Warn provides a mock function with given fields: msg.
-func (_m MockLogger) Warn(msg string) {
+func (_m *MockLogger) Warn(msg string) {
 	_m.Logger.Warn(msg)
 }
 
+// DebugCtx provides a mock function with given fields: ctx, msg.
+func (_m *MockLogger) DebugCtx(ctx context.Context, msg string) {
+	_m.Logger.DebugCtx(ctx, msg)
+}
+
+// InfoCtx provides a mock function with given fields: ctx, msg.
+func (_m *MockLogger) InfoCtx(ctx context.Context, msg string) {
+	_m.Logger.InfoCtx(ctx, msg)
+}
+
+// WarnCtx provides a mock function with given fields: ctx, msg.
+func (_m *MockLogger) WarnCtx(ctx context.Context, msg string) {
+	_m.Logger.WarnCtx(ctx, msg)
+}
+
+// ErrorCtx provides a mock function with given fields: ctx, msg.
+func (_m *MockLogger) ErrorCtx(ctx context.Context, msg string) {
+	_m.Logger.ErrorCtx(ctx, msg)
+}
+
+// FatalCtx provides a mock function with given fields: ctx, msg.
+func (_m *MockLogger) FatalCtx(ctx context.Context, msg string) {
+	_m.Logger.FatalCtx(ctx, msg)
+}
+
 // WithError provides a mock function with given fields: err.
-func (_m MockLogger) WithError(err error) model.Logger {
+func (_m *MockLogger) WithError(err error) model.Logger {
 	return _m.Logger.WithError(err)
 }
 
 // WithField provides a mock function with given fields: key, value.
-func (_m MockLogger) WithField(key string, value interface{}) model.Logger {
+func (_m *MockLogger) WithField(key string, value interface{}) model.Logger {
 	return _m.Logger.WithField(key, value)
 }
 
 // WithFields provides a mock function with given fields: fields.
-func (_m MockLogger) WithFields(fields model.Fields) model.Logger {
+func (_m *MockLogger) WithFields(fields model.Fields) model.Logger {
 	return _m.Logger.WithFields(fields)
 }
 
-// WithFields provides a mock function with given fields: fields.
-func (_m MockLogger) ToKeyValLogger() model.KeyValLogger {
+// ToKeyValLogger provides a mock function with given fields:.
+func (_m *MockLogger) ToKeyValLogger() model.KeyValLogger {
 	return _m.Logger.ToKeyValLogger()
 }
@@ -0,0 +1,207 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Names of the outputs that are not file paths in Settings.Output.
+const (
+	OutputStdout = "stdout"
+	OutputStderr = "stderr"
+)
+
+// Environment variables read by Settings.ApplyEnv.
+const (
+	EnvLevel           = "LOG_LEVEL"
+	EnvFormat          = "LOG_FORMAT"
+	EnvOutput          = "LOG_OUTPUT"
+	EnvSource          = "LOG_SOURCE"
+	EnvComponentLevels = "LOG_LEVELS"
+)
+
+var errUnknownFileType = errors.New("unknown configuration file type, expected .json, .yaml or .yml")
+
+// Settings is the serializable part of Config, loaded from environment variables or from a configuration
+// file. Unlike Config, it rejects invalid values instead of falling back to defaults.
+type Settings struct {
+	// Level is the lowest level emitted, as in Config.Level.
+	Level string `json:"level"`
+
+	// Format is json, text or console, as in Config.Format.
+	Format string `json:"format"`
+
+	// Output is stdout, stderr or the path of a log file. The default output is stdout.
+	Output string `json:"output"`
+
+	// Source adds the source of log messages, as Config.IncludeSource.
+	Source bool `json:"source"`
+
+	// Components are the levels of named loggers, as in Config.ComponentLevels.
+	Components map[string]string `json:"components"`
+
+	// Rotation rotates the log file when Output is a file path. The file is never rotated when nil.
+	Rotation *RotationSettings `json:"rotation"`
+
+	// Async writes log messages from a background goroutine, as with Config.Async. Messages are written
+	// synchronously when nil.
+	Async *AsyncSettings `json:"async"`
+}
+
+// RotationSettings is the serializable form of the rotation part of FileConfig.
+type RotationSettings struct {
+	// MaxSize is the size in bytes after which the file is rotated.
+	MaxSize int64 `json:"max_size"`
+
+	// Interval is the age after which the file is rotated, e.g. 24h.
+	Interval string `json:"interval"`
+
+	// MaxBackups is the number of backups to keep. Zero keeps all backups.
+	MaxBackups int `json:"max_backups"`
+
+	// Compress gzips backups.
+	Compress bool `json:"compress"`
+}
+
+// AsyncSettings is the serializable form of AsyncConfig.
+type AsyncSettings struct {
+	// QueueSize is the number of messages waiting to be written. The default size is 1024.
+	QueueSize int `json:"queue_size"`
+
+	// Overflow is block, drop_newest or drop_oldest. The default policy is block.
+	Overflow string `json:"overflow"`
+}
+
+// SettingsFromEnv returns the settings set by the LOG_LEVEL, LOG_FORMAT, LOG_OUTPUT, LOG_SOURCE and
+// LOG_LEVELS environment variables, looked up with getenv, which is usually os.Getenv.
+func SettingsFromEnv(getenv func(string) string) (*Settings, error) {
+	settings := &Settings{}
+	if err := settings.ApplyEnv(getenv); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// SettingsFromFile reads the settings from the section of a JSON or YAML file, chosen by its extension.
+// section is a dotted path such as "logging" or "worker.logging"; an empty section reads the whole file.
+// Unknown keys are rejected.
+func SettingsFromFile(path, section string) (*Settings, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read log settings: %w", err)
+	}
+
+	var document interface{}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		err = json.Unmarshal(data, &document)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &document)
+	default:
+		return nil, fmt.Errorf("%s: %w", path, errUnknownFileType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+
+	if section != "" {
+		for _, key := range strings.Split(section, ".") {
+			object, ok := document.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%s: section %q not found", path, section)
+			}
+			if document, ok = object[key]; !ok {
+				return nil, fmt.Errorf("%s: section %q not found", path, section)
+			}
+		}
+	}
+
+	// the section is decoded through JSON so that both formats share the json tags and their checks
+	encoded, err := json.Marshal(document)
+	if err != nil {
+		return nil, fmt.Errorf("%s: encode section %q: %w", path, section, err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.DisallowUnknownFields()
+	settings := &Settings{}
+	if err := decoder.Decode(settings); err != nil {
+		return nil, fmt.Errorf("%s: decode section %q: %w", path, section, err)
+	}
+	return settings, nil
+}
+
+// ApplyEnv overrides the settings with the environment variables that are set, e.g. to adjust
+// the settings of a configuration file.
+func (s *Settings) ApplyEnv(getenv func(string) string) error {
+	if level := getenv(EnvLevel); level != "" {
+		s.Level = level
+	}
+	if format := getenv(EnvFormat); format != "" {
+		s.Format = format
+	}
+	if output := getenv(EnvOutput); output != "" {
+		s.Output = output
+	}
+	if source := getenv(EnvSource); source != "" {
+		includeSource, err := strconv.ParseBool(source)
+		if err != nil {
+			return fmt.Errorf("%s: invalid boolean %q", EnvSource, source)
+		}
+		s.Source = includeSource
+	}
+	if components := getenv(EnvComponentLevels); components != "" {
+		levels, err := ParseComponentLevels(components)
+		if err != nil {
+			return fmt.Errorf("%s: %w", EnvComponentLevels, err)
+		}
+		s.Components = levels
+	}
+	return nil
+}
+
+// Validate returns an error for every invalid value of the settings.
+func (s *Settings) Validate() error {
+	config := &Config{Level: s.Level, Format: s.Format, ComponentLevels: s.Components}
+	errs := []error{config.Validate()}
+	if s.Rotation != nil {
+		if _, err := s.Rotation.GetInterval(); err != nil {
+			errs = append(errs, err)
+		}
+		if s.Rotation.MaxSize < 0 {
+			errs = append(errs, fmt.Errorf("rotation max_size: negative size %d", s.Rotation.MaxSize))
+		}
+		if s.Rotation.MaxBackups < 0 {
+			errs = append(errs, fmt.Errorf("rotation max_backups: negative count %d", s.Rotation.MaxBackups))
+		}
+	}
+	if s.Async != nil {
+		if _, err := ParseOverflowPolicy(s.Async.Overflow); err != nil {
+			errs = append(errs, fmt.Errorf("async overflow: %w", err))
+		}
+		if s.Async.QueueSize < 0 {
+			errs = append(errs, fmt.Errorf("async queue_size: negative size %d", s.Async.QueueSize))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// GetInterval parses Interval; an empty interval is zero.
+func (r *RotationSettings) GetInterval() (time.Duration, error) {
+	if r.Interval == "" {
+		return 0, nil
+	}
+	interval, err := time.ParseDuration(r.Interval)
+	if err != nil || interval < 0 {
+		return 0, fmt.Errorf("rotation interval: invalid duration %q", r.Interval)
+	}
+	return interval, nil
+}
@@ -0,0 +1,153 @@
+package model_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	logModel "github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+func TestSettingsFromEnv(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		env     map[string]string
+		want    *logModel.Settings
+		wantErr string
+	}{
+		{name: "empty", env: map[string]string{}, want: &logModel.Settings{}},
+		{
+			name: "all variables",
+			env: map[string]string{
+				"LOG_LEVEL":  "debug",
+				"LOG_FORMAT": "console",
+				"LOG_OUTPUT": "stderr",
+				"LOG_SOURCE": "true",
+				"LOG_LEVELS": "orchestrator.temporal=warn",
+			},
+			want: &logModel.Settings{
+				Level:      "debug",
+				Format:     "console",
+				Output:     "stderr",
+				Source:     true,
+				Components: map[string]string{"orchestrator.temporal": "warn"},
+			},
+		},
+		{name: "invalid source", env: map[string]string{"LOG_SOURCE": "maybe"}, wantErr: "LOG_SOURCE"},
+		{name: "invalid component levels", env: map[string]string{"LOG_LEVELS": "temporal"}, wantErr: "LOG_LEVELS"},
+	}
+	for _, tC := range tests {
+		tt := tC
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			settings, err := logModel.SettingsFromEnv(func(key string) string { return tt.env[key] })
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, settings)
+		})
+	}
+}
+
+func TestSettingsFromFile(t *testing.T) {
+	t.Parallel()
+	want := &logModel.Settings{
+		Level:      "debug",
+		Format:     "text",
+		Output:     "/var/log/worker.log",
+		Components: map[string]string{"orchestrator.temporal.sdk": "warn"},
+		Rotation:   &logModel.RotationSettings{MaxSize: 1048576, Interval: "24h", MaxBackups: 3, Compress: true},
+		Async:      &logModel.AsyncSettings{QueueSize: 4096, Overflow: "drop_oldest"},
+	}
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		section string
+		want    *logModel.Settings
+		wantErr string
+	}{
+		{
+			name: "yaml section",
+			file: "config.yaml",
+			content: `
+temporal:
+  address: localhost:7233
+worker:
+  logging:
+    level: debug
+    format: text
+    output: /var/log/worker.log
+    components:
+      orchestrator.temporal.sdk: warn
+    rotation:
+      max_size: 1048576
+      interval: 24h
+      max_backups: 3
+      compress: true
+    async:
+      queue_size: 4096
+      overflow: drop_oldest
+`,
+			section: "worker.logging",
+			want:    want,
+		},
+		{
+			name: "json file",
+			file: "logging.json",
+			content: `{"level": "debug", "format": "text", "output": "/var/log/worker.log",
+				"components": {"orchestrator.temporal.sdk": "warn"},
+				"rotation": {"max_size": 1048576, "interval": "24h", "max_backups": 3, "compress": true},
+				"async": {"queue_size": 4096, "overflow": "drop_oldest"}}`,
+			want: want,
+		},
+		{name: "missing section", file: "config.yml", content: "temporal: {}", section: "logging", wantErr: "not found"},
+		{name: "unknown key", file: "config.yml", content: "levle: debug", wantErr: "levle"},
+		{name: "invalid syntax", file: "config.json", content: "{", wantErr: "parse"},
+		{name: "unknown file type", file: "config.toml", content: "", wantErr: "unknown configuration file type"},
+	}
+	for _, tC := range tests {
+		tt := tC
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			path := filepath.Join(t.TempDir(), tt.file)
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+
+			settings, err := logModel.SettingsFromFile(path, tt.section)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, settings)
+		})
+	}
+
+	_, err := logModel.SettingsFromFile(filepath.Join(t.TempDir(), "missing.yaml"), "")
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestSettings_Validate(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, (&logModel.Settings{}).Validate())
+
+	err := (&logModel.Settings{
+		Level:      "verbose",
+		Format:     "xml",
+		Components: map[string]string{"temporal": "loud"},
+		Rotation:   &logModel.RotationSettings{Interval: "daily", MaxSize: -1},
+		Async:      &logModel.AsyncSettings{QueueSize: -1, Overflow: "drop_all"},
+	}).Validate()
+	require.ErrorIs(t, err, logModel.ErrUnknownLevel)
+	require.ErrorIs(t, err, logModel.ErrUnknownFormat)
+	require.ErrorIs(t, err, logModel.ErrUnknownOverflowPolicy)
+	for _, want := range []string{`"verbose"`, `"xml"`, "component temporal", `"daily"`, "max_size", "queue_size"} {
+		assert.ErrorContains(t, err, want)
+	}
+}
@@ -1,4 +1,13 @@
 package model
 
+import (
+	"context"
+	"log/slog"
+)
+
 // Fields is a map of strings to any type. It is used to pass to Logger.WithFields.
 type Fields map[string]interface{}
+
+// ContextExtractor returns the attributes to log from a context, e.g. the trace ID of its span.
+// It returns nil when the context holds none of them.
+type ContextExtractor func(ctx context.Context) []slog.Attr
@@ -0,0 +1,74 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+)
+
+// multiHandler fans records out to several handlers.
+type multiHandler struct {
+	handlers []slog.Handler
+}
+
+// NewMultiHandler returns a slog.Handler passing every record to each of handlers that is enabled
+// for its level. A handler failing does not prevent the others from handling the record; the errors
+// of all handlers are joined. Handlers are called in turn by the caller, so a blocking handler holds up
+// the others: wrap each handler in an AsyncHandler to give it its own queue.
+//
+//nolint:ireturn // returns the slog.Handler interface
+func NewMultiHandler(handlers ...slog.Handler) slog.Handler {
+	return &multiHandler{handlers: handlers}
+}
+
+func (h *multiHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	for _, handler := range h.handlers {
+		if handler.Enabled(ctx, level) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *multiHandler) Handle(ctx context.Context, record slog.Record) error {
+	var errs []error
+	for _, handler := range h.handlers {
+		if !handler.Enabled(ctx, record.Level) {
+			continue
+		}
+		// each handler gets its own copy, as handlers may add attributes to the record
+		if err := handler.Handle(ctx, record.Clone()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+//nolint:ireturn // implements slog.Handler interface
+func (h *multiHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	handlers := make([]slog.Handler, 0, len(h.handlers))
+	for _, handler := range h.handlers {
+		handlers = append(handlers, handler.WithAttrs(attrs))
+	}
+	return &multiHandler{handlers: handlers}
+}
+
+//nolint:ireturn // implements slog.Handler interface
+func (h *multiHandler) WithGroup(name string) slog.Handler {
+	handlers := make([]slog.Handler, 0, len(h.handlers))
+	for _, handler := range h.handlers {
+		handlers = append(handlers, handler.WithGroup(name))
+	}
+	return &multiHandler{handlers: handlers}
+}
+
+// sinkLeveler is the minimum level of a sink: the higher of the sink level and the logger level,
+// so that SetLevel on the logger still applies to the sink.
+type sinkLeveler struct {
+	logger slog.Leveler
+	sink   slog.Level
+}
+
+func (l *sinkLeveler) Level() slog.Level {
+	return max(l.logger.Level(), l.sink)
+}
@@ -0,0 +1,113 @@
+package logger_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger"
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+var errWrite = errors.New("disk full")
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errWrite }
+
+func TestSlogLogger_Sinks(t *testing.T) {
+	t.Parallel()
+	jsonOutput, textOutput, consoleOutput := new(strings.Builder), new(strings.Builder), new(strings.Builder)
+	log := logger.NewSlogLogger(&model.Config{
+		Level: "debug",
+		Sinks: []model.SinkConfig{
+			{Output: jsonOutput, Format: "json"},
+			{Output: textOutput, Format: "text", Level: "warn"},
+			{Output: consoleOutput, Format: "console", Level: "error"},
+		},
+	})
+
+	log.WithField("order", 42).Debug("looking up order")
+	log.WithField("order", 42).Warn("order is late")
+	log.WithField("order", 42).Error("order lost")
+
+	assert.Contains(t, jsonOutput.String(), `"msg":"looking up order","order":42`)
+	assert.Contains(t, jsonOutput.String(), `"msg":"order lost","order":42`)
+	assert.NotContains(t, textOutput.String(), "looking up order")
+	assert.Contains(t, textOutput.String(), `msg="order is late" order=42`)
+	assert.NotContains(t, consoleOutput.String(), "order is late")
+	assert.Contains(t, consoleOutput.String(), "order lost")
+
+	// the logger level applies to every sink
+	log.SetLevel(model.ErrorLevel)
+	log.Warn("order is very late")
+	log.Error("order found")
+	assert.NotContains(t, jsonOutput.String(), "order is very late")
+	assert.NotContains(t, textOutput.String(), "order is very late")
+	assert.Contains(t, jsonOutput.String(), "order found")
+	assert.Contains(t, textOutput.String(), "order found")
+	assert.Contains(t, consoleOutput.String(), "order found")
+}
+
+// blockingWriter blocks writes until it is released.
+type blockingWriter struct {
+	release chan struct{}
+}
+
+func (w *blockingWriter) Write(p []byte) (int, error) {
+	<-w.release
+	return len(p), nil
+}
+
+func TestSlogLogger_SinksBlocked(t *testing.T) {
+	t.Parallel()
+	blocked, output := &blockingWriter{release: make(chan struct{})}, new(syncBuilder)
+	log := logger.NewSlogLogger(&model.Config{
+		Sinks: []model.SinkConfig{{Output: blocked}, {Output: output}},
+		Async: &model.AsyncConfig{QueueSize: 8},
+	})
+
+	for range 3 {
+		log.Info("order shipped")
+	}
+	require.Eventually(t, func() bool { return strings.Count(output.String(), "order shipped") == 3 },
+		5*time.Second, 10*time.Millisecond, "a blocked sink does not hold up the others")
+
+	close(blocked.release)
+	require.NoError(t, log.Close(context.Background()))
+	assert.Equal(t, logger.AsyncStats{Written: 6}, log.AsyncStats())
+}
+
+func TestMultiHandler(t *testing.T) {
+	t.Parallel()
+	first, second := new(strings.Builder), new(strings.Builder)
+	handler := logger.NewMultiHandler(
+		slog.NewJSONHandler(first, nil),
+		slog.NewTextHandler(failingWriter{}, nil),
+		slog.NewJSONHandler(second, &slog.HandlerOptions{Level: slog.LevelWarn}),
+	)
+	assert.True(t, handler.Enabled(context.Background(), slog.LevelInfo))
+	assert.False(t, handler.Enabled(context.Background(), slog.LevelDebug))
+
+	log := slog.New(handler).With("service", "billing").WithGroup("charge")
+	log.Warn("retrying", "attempt", 2)
+	log.Info("charged", "amount", 10)
+
+	assert.Contains(t, first.String(), `"msg":"retrying","service":"billing","charge":{"attempt":2}`)
+	assert.Contains(t, first.String(), `"msg":"charged"`)
+	assert.Contains(t, second.String(), `"msg":"retrying","service":"billing","charge":{"attempt":2}`)
+	assert.NotContains(t, second.String(), "charged")
+
+	// a failing sink does not prevent the others from writing the record
+	record := slog.NewRecord(time.Now(), slog.LevelError, "charge failed", 0)
+	err := handler.Handle(context.Background(), record)
+	require.ErrorIs(t, err, errWrite)
+	assert.Contains(t, first.String(), "charge failed")
+	assert.Contains(t, second.String(), "charge failed")
+}
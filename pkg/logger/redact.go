@@ -0,0 +1,263 @@
+package logger
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+// maximum nesting walked when redacting maps, slices and structs, which also stops reference cycles.
+const maxRedactionDepth = 10
+
+// redactor masks sensitive data according to a model.RedactionConfig.
+type redactor struct {
+	keys          map[string]struct{}
+	keyPatterns   []*regexp.Regexp
+	valuePatterns []*regexp.Regexp
+	mask          string
+}
+
+func newRedactor(config *model.RedactionConfig) *redactor {
+	keys := make(map[string]struct{}, len(config.Keys))
+	for _, key := range config.Keys {
+		keys[strings.ToLower(key)] = struct{}{}
+	}
+	return &redactor{
+		keys:          keys,
+		keyPatterns:   config.KeyPatterns,
+		valuePatterns: config.ValuePatterns,
+		mask:          config.GetMask(),
+	}
+}
+
+// replaceAttr is used as slog.HandlerOptions.ReplaceAttr. An attribute is masked when its key or the
+// name of one of its groups is sensitive; otherwise its value is redacted.
+func (r *redactor) replaceAttr(groups []string, a slog.Attr) slog.Attr {
+	if len(groups) == 0 {
+		switch a.Key {
+		case slog.TimeKey, slog.LevelKey, slog.SourceKey:
+			return a
+		case slog.MessageKey:
+			return slog.String(a.Key, r.redactString(a.Value.String()))
+		}
+	}
+	for _, group := range groups {
+		if r.isSensitiveKey(group) {
+			return slog.String(a.Key, r.mask)
+		}
+	}
+	if r.isSensitiveKey(a.Key) {
+		return slog.String(a.Key, r.mask)
+	}
+
+	switch a.Value.Kind() {
+	case slog.KindString:
+		a.Value = slog.StringValue(r.redactString(a.Value.String()))
+	case slog.KindAny:
+		a.Value = slog.AnyValue(r.redact(a.Value.Any(), 0))
+	default:
+	}
+	return a
+}
+
+func (r *redactor) isSensitiveKey(key string) bool {
+	if _, ok := r.keys[strings.ToLower(key)]; ok {
+		return true
+	}
+	for _, pattern := range r.keyPatterns {
+		if pattern.MatchString(key) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *redactor) redactString(s string) string {
+	for _, pattern := range r.valuePatterns {
+		s = pattern.ReplaceAllLiteralString(s, r.mask)
+	}
+	return s
+}
+
+// redact returns v with sensitive data masked. Maps, slices and structs are copied into generic
+// values, struct fields being named and embedded structs flattened as by encoding/json, so that they are
+// encoded as before. Values with their own text or JSON encoding keep it unless they hold sensitive data.
+//
+//nolint:cyclop // one case per kind of value
+func (r *redactor) redact(v interface{}, depth int) interface{} {
+	if v == nil || depth > maxRedactionDepth {
+		return v
+	}
+	switch value := v.(type) {
+	case model.Redactor:
+		return r.redact(value.Redact(), depth+1)
+	case error:
+		return r.redactString(value.Error())
+	case json.Marshaler, encoding.TextMarshaler, fmt.Stringer:
+		if !r.hasSensitiveData(reflect.ValueOf(v), depth) {
+			return v
+		}
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String:
+		return r.redactString(rv.String())
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return v
+		}
+		return r.redact(rv.Elem().Interface(), depth+1)
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return v
+		}
+		redacted := make(map[string]interface{}, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			redacted[iter.Key().String()] = r.redactField(iter.Key().String(), iter.Value(), depth)
+		}
+		return redacted
+	case reflect.Slice, reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return v
+		}
+		redacted := make([]interface{}, rv.Len())
+		for i := range redacted {
+			redacted[i] = r.redact(rv.Index(i).Interface(), depth+1)
+		}
+		return redacted
+	case reflect.Struct:
+		return r.redactStruct(rv, depth)
+	default:
+		return v
+	}
+}
+
+func (r *redactor) redactStruct(rv reflect.Value, depth int) map[string]interface{} {
+	fields := structFields(rv)
+	redacted := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		redacted[field.name] = r.redactField(field.name, field.value, depth)
+	}
+	return redacted
+}
+
+// hasSensitiveData reports whether redacting the value would change it: it holds a sensitive key, a string
+// matching a value pattern or a model.Redactor.
+//
+//nolint:cyclop // one case per kind of value
+func (r *redactor) hasSensitiveData(rv reflect.Value, depth int) bool {
+	if !rv.IsValid() || depth > maxRedactionDepth {
+		return false
+	}
+	if rv.CanInterface() {
+		if _, ok := rv.Interface().(model.Redactor); ok {
+			return true
+		}
+	}
+	switch rv.Kind() {
+	case reflect.String:
+		return r.redactString(rv.String()) != rv.String()
+	case reflect.Pointer, reflect.Interface:
+		return !rv.IsNil() && r.hasSensitiveData(rv.Elem(), depth+1)
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return false
+		}
+		iter := rv.MapRange()
+		for iter.Next() {
+			if r.isSensitiveKey(iter.Key().String()) || r.hasSensitiveData(iter.Value(), depth+1) {
+				return true
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return false
+		}
+		for i := range rv.Len() {
+			if r.hasSensitiveData(rv.Index(i), depth+1) {
+				return true
+			}
+		}
+	case reflect.Struct:
+		for _, field := range structFields(rv) {
+			if r.isSensitiveKey(field.name) || r.hasSensitiveData(field.value, depth+1) {
+				return true
+			}
+		}
+	default:
+	}
+	return false
+}
+
+// structField is a field of a struct as encoded by encoding/json.
+type structField struct {
+	name  string
+	value reflect.Value
+}
+
+// structFields returns the exported fields of rv named after their json tag. The fields of embedded structs
+// without a json name are promoted into rv, unless rv has a field with the same name.
+func structFields(rv reflect.Value) []structField {
+	var fields, promoted []structField
+	names := make(map[string]struct{}, rv.NumField())
+	for i := range rv.NumField() {
+		field := rv.Type().Field(i)
+		name := field.Name
+		if tag, ok := field.Tag.Lookup("json"); ok {
+			tagName, _, _ := strings.Cut(tag, ",")
+			if tagName == "-" {
+				continue
+			}
+			if tagName != "" {
+				name = tagName
+			} else if field.Anonymous {
+				name = ""
+			}
+		} else if field.Anonymous {
+			name = ""
+		}
+
+		value := rv.Field(i)
+		if name == "" {
+			embedded := value
+			if embedded.Kind() == reflect.Pointer {
+				if embedded.IsNil() {
+					continue
+				}
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				promoted = append(promoted, structFields(embedded)...)
+				continue
+			}
+			name = field.Name
+		}
+		if !field.IsExported() || !value.CanInterface() {
+			continue
+		}
+		fields = append(fields, structField{name: name, value: value})
+		names[name] = struct{}{}
+	}
+	for _, field := range promoted {
+		if _, ok := names[field.name]; !ok {
+			fields = append(fields, field)
+			names[field.name] = struct{}{}
+		}
+	}
+	return fields
+}
+
+func (r *redactor) redactField(key string, value reflect.Value, depth int) interface{} {
+	if r.isSensitiveKey(key) {
+		return r.mask
+	}
+	return r.redact(value.Interface(), depth+1)
+}
@@ -0,0 +1,180 @@
+package logger_test
+
+import (
+	"errors"
+	"log/slog"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger"
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+type ssn string
+
+func (s ssn) Redact() interface{} { return "***-**-" + string(s[len(s)-4:]) }
+
+type customer struct {
+	Name     string            `json:"name"`
+	Email    string            `json:"email"`
+	SSN      ssn               `json:"ssn"`
+	Password string            `json:"password"`
+	Internal string            `json:"-"`
+	Tags     []string          `json:"tags"`
+	Meta     map[string]string `json:"meta"`
+	private  string
+}
+
+// credentials has its own text encoding, which would print its sensitive fields.
+type credentials struct {
+	User     string `json:"user"`
+	Password string `json:"password"`
+}
+
+func (c credentials) String() string { return c.User + ":" + c.Password }
+
+type account struct {
+	credentials
+	Plan string `json:"plan"`
+}
+
+func newRedactingLogger(format string) (*logger.SlogLogger, *strings.Builder) {
+	output := new(strings.Builder)
+	return logger.NewSlogLogger(&model.Config{
+		Output: output,
+		Format: format,
+		Redaction: &model.RedactionConfig{
+			Keys:          []string{"password", "SSN"},
+			KeyPatterns:   []*regexp.Regexp{regexp.MustCompile(`(?i)token`)},
+			ValuePatterns: []*regexp.Regexp{regexp.MustCompile(`[\w.+-]+@[\w-]+\.[\w.]+`)},
+		},
+	}), output
+}
+
+func TestSlogLogger_Redaction(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		log     func(log *logger.SlogLogger)
+		want    []string
+		notWant []string
+	}{
+		{
+			name: "keys and key patterns",
+			log: func(log *logger.SlogLogger) {
+				log.WithFields(model.Fields{"password": "hunter2", "AuthToken": "abc", "user": "bob"}).Info("login")
+			},
+			want:    []string{`"password":"[REDACTED]"`, `"AuthToken":"[REDACTED]"`, `"user":"bob"`},
+			notWant: []string{"hunter2", "abc"},
+		},
+		{
+			name: "value patterns in values and messages",
+			log: func(log *logger.SlogLogger) {
+				log.WithField("to", "bob@example.com").Info("mail sent to alice@example.com")
+			},
+			want:    []string{`"msg":"mail sent to [REDACTED]"`, `"to":"[REDACTED]"`},
+			notWant: []string{"example.com"},
+		},
+		{
+			name: "redactor",
+			log: func(log *logger.SlogLogger) {
+				log.WithField("id", ssn("123-45-6789")).Info("verified")
+			},
+			want:    []string{`"id":"***-**-6789"`},
+			notWant: []string{"123-45"},
+		},
+		{
+			name: "errors",
+			log: func(log *logger.SlogLogger) {
+				log.WithError(errors.New("no account for bob@example.com")).Error("lookup failed")
+			},
+			want:    []string{`"error":"no account for [REDACTED]"`},
+			notWant: []string{"example.com"},
+		},
+		{
+			name: "structs",
+			log: func(log *logger.SlogLogger) {
+				log.WithField("customer", &customer{
+					Name:     "Bob",
+					Email:    "bob@example.com",
+					SSN:      "123-45-6789",
+					Password: "hunter2",
+					Internal: "internal",
+					Tags:     []string{"vip", "bob@example.com"},
+					Meta:     map[string]string{"api_token": "abc", "plan": "pro"},
+					private:  "private",
+				}).Info("customer created")
+			},
+			want: []string{
+				`"name":"Bob"`, `"email":"[REDACTED]"`, `"ssn":"[REDACTED]"`, `"password":"[REDACTED]"`,
+				`"tags":["vip","[REDACTED]"]`, `"meta":{"api_token":"[REDACTED]","plan":"pro"}`,
+			},
+			notWant: []string{"example.com", "6789", "hunter2", "internal", "abc", "private"},
+		},
+		{
+			name: "stringer structs",
+			log: func(log *logger.SlogLogger) {
+				log.WithField("login", credentials{User: "bob", Password: "hunter2"}).Info("logged in")
+			},
+			want:    []string{`"login":{`, `"user":"bob"`, `"password":"[REDACTED]"`},
+			notWant: []string{"hunter2"},
+		},
+		{
+			name: "embedded structs",
+			log: func(log *logger.SlogLogger) {
+				log.WithField("account", &account{
+					credentials: credentials{User: "bob", Password: "hunter2"},
+					Plan:        "pro",
+				}).Info("account created")
+			},
+			want:    []string{`"plan":"pro"`, `"user":"bob"`, `"password":"[REDACTED]"`},
+			notWant: []string{"hunter2", "credentials"},
+		},
+		{
+			name: "stringers without sensitive data",
+			log: func(log *logger.SlogLogger) {
+				log.WithField("timeout", 5*time.Second).Info("timed out")
+			},
+			want: []string{`"timeout":5000000000`},
+		},
+	}
+	for _, tC := range tests {
+		tt := tC
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			log, output := newRedactingLogger("json")
+			tt.log(log)
+
+			for _, want := range tt.want {
+				assert.Contains(t, output.String(), want)
+			}
+			for _, notWant := range tt.notWant {
+				assert.NotContains(t, output.String(), notWant)
+			}
+		})
+	}
+}
+
+func TestSlogLogger_RedactionGroups(t *testing.T) {
+	t.Parallel()
+	for _, format := range []string{"json", "text", "console"} {
+		log, output := newRedactingLogger(format)
+		entry, ok := log.ToKeyValLogger().(*slog.Logger)
+		require.True(t, ok)
+
+		entry.WithGroup("request").Info("call",
+			slog.Group("password", "old", "hunter2", "new", "hunter3"),
+			slog.Group("headers", "X-Token", "abc", "Accept", "json"),
+		)
+
+		assert.NotContains(t, output.String(), "hunter", format)
+		assert.NotContains(t, output.String(), "abc", format)
+		assert.Contains(t, output.String(), "json", format)
+		assert.Contains(t, output.String(), "[REDACTED]", format)
+	}
+}
@@ -0,0 +1,37 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+)
+
+// NewReplaySafeLogger returns a logger writing through log that drops every entry while isReplaying
+// returns true, so that workflow code does not repeat its logs each time its history is replayed.
+func NewReplaySafeLogger(log *SlogLogger, isReplaying func() bool) *SlogLogger {
+	return log.derive(&replaySafeHandler{handler: log.handler, isReplaying: isReplaying}, log.name)
+}
+
+// replaySafeHandler disables its handler while isReplaying returns true.
+type replaySafeHandler struct {
+	handler     slog.Handler
+	isReplaying func() bool
+}
+
+func (h *replaySafeHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return !h.isReplaying() && h.handler.Enabled(ctx, level)
+}
+
+//nolint:wrapcheck // errors are passed through unchanged
+func (h *replaySafeHandler) Handle(ctx context.Context, record slog.Record) error {
+	return h.handler.Handle(ctx, record)
+}
+
+//nolint:ireturn // implements slog.Handler interface
+func (h *replaySafeHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &replaySafeHandler{handler: h.handler.WithAttrs(attrs), isReplaying: h.isReplaying}
+}
+
+//nolint:ireturn // implements slog.Handler interface
+func (h *replaySafeHandler) WithGroup(name string) slog.Handler {
+	return &replaySafeHandler{handler: h.handler.WithGroup(name), isReplaying: h.isReplaying}
+}
@@ -0,0 +1,25 @@
+package logger_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger"
+)
+
+func TestReplaySafeLogger(t *testing.T) {
+	t.Parallel()
+	slogLogger, output := makeTestLogger()
+	replaying := true
+	log := logger.NewReplaySafeLogger(slogLogger, func() bool { return replaying }).WithField("key", "demo")
+
+	log.Info("replayed msg")
+	assert.Empty(t, output.String())
+
+	replaying = false
+	log.Info("new msg")
+	assert.Contains(t, output.String(), "new msg")
+	assert.Contains(t, output.String(), `"key":"demo"`)
+	assert.NotContains(t, output.String(), "replayed msg")
+}
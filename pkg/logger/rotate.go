@@ -0,0 +1,325 @@
+package logger
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+const (
+	// layout of the timestamp in backup names, which sorts chronologically.
+	backupTimeFormat = "2006-01-02T15-04-05.000000000"
+	compressedSuffix = ".gz"
+
+	logFileMode = 0o644
+	logDirMode  = 0o755
+)
+
+var errMissingFilename = errors.New("missing log file name")
+
+// RotatingFile is an io.Writer, usable as model.Config.Output, writing to a file that is rotated by size
+// and by time according to a model.FileConfig. Rotation by time happens on the first write after the
+// interval elapsed. Backups are named after the file and the rotation time, e.g. worker-<time>.log,
+// and are compressed and pruned in the background.
+//
+// The file is reopened on SIGHUP, so that it can also be rotated by external tools such as logrotate.
+// RotatingFile is safe for concurrent use; Close must be called to flush the file and wait for
+// pending compressions.
+type RotatingFile struct {
+	config   model.FileConfig
+	mu       sync.Mutex
+	file     *os.File
+	size     int64
+	openedAt time.Time
+	closed   bool
+	now      func() time.Time
+	mill     chan struct{}
+	millDone chan struct{}
+	signals  chan os.Signal
+}
+
+// NewRotatingFile opens, or creates, the log file of config and starts listening for SIGHUP.
+func NewRotatingFile(config *model.FileConfig) (*RotatingFile, error) {
+	if config.Filename == "" {
+		return nil, errMissingFilename
+	}
+	if err := os.MkdirAll(filepath.Dir(config.Filename), logDirMode); err != nil {
+		return nil, fmt.Errorf("create log directory: %w", err)
+	}
+
+	f := &RotatingFile{
+		config:   *config,
+		now:      time.Now,
+		mill:     make(chan struct{}, 1),
+		millDone: make(chan struct{}),
+		signals:  make(chan os.Signal, 1),
+	}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+
+	go f.runMill()
+	// backups left over by a previous process are compressed and pruned as well
+	f.notifyMill()
+
+	signal.Notify(f.signals, syscall.SIGHUP)
+	go func() {
+		for range f.signals {
+			if err := f.Reopen(); err != nil {
+				fmt.Fprintf(os.Stderr, "reopen log file %s: %v\n", f.config.Filename, err)
+			}
+		}
+	}()
+	return f, nil
+}
+
+// Write writes p to the current file, rotating it first when it is due.
+func (f *RotatingFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return 0, os.ErrClosed
+	}
+	if f.shouldRotate(int64(len(p))) {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+	if err != nil {
+		return n, fmt.Errorf("write log file: %w", err)
+	}
+	return n, nil
+}
+
+// Rotate renames the current file to a backup and starts a new one.
+func (f *RotatingFile) Rotate() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return os.ErrClosed
+	}
+	return f.rotate()
+}
+
+// Reopen closes the current file and opens the file name again, which creates a new file
+// when the previous one was moved away.
+func (f *RotatingFile) Reopen() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return os.ErrClosed
+	}
+	if err := f.file.Close(); err != nil {
+		return fmt.Errorf("close log file: %w", err)
+	}
+	return f.open()
+}
+
+// Sync flushes the current file to disk.
+func (f *RotatingFile) Sync() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return os.ErrClosed
+	}
+	if err := f.file.Sync(); err != nil {
+		return fmt.Errorf("sync log file: %w", err)
+	}
+	return nil
+}
+
+// Close stops listening for SIGHUP, flushes and closes the file and waits for pending compressions.
+// Closing an already closed file does nothing.
+func (f *RotatingFile) Close() error {
+	f.mu.Lock()
+	if f.closed {
+		f.mu.Unlock()
+		return nil
+	}
+	f.closed = true
+	signal.Stop(f.signals)
+	close(f.signals)
+
+	var errs []error
+	if err := f.file.Sync(); err != nil {
+		errs = append(errs, fmt.Errorf("sync log file: %w", err))
+	}
+	if err := f.file.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("close log file: %w", err))
+	}
+	f.mu.Unlock()
+
+	close(f.mill)
+	<-f.millDone
+	return errors.Join(errs...)
+}
+
+func (f *RotatingFile) open() error {
+	file, err := os.OpenFile(f.config.Filename, os.O_CREATE|os.O_WRONLY|os.O_APPEND, logFileMode)
+	if err != nil {
+		return fmt.Errorf("open log file: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("stat log file: %w", err)
+	}
+	f.file = file
+	f.size = info.Size()
+	f.openedAt = f.now()
+	return nil
+}
+
+// shouldRotate reports whether the file must be rotated before writing n bytes.
+// An empty file is never rotated, so that writes larger than MaxSize are not lost.
+func (f *RotatingFile) shouldRotate(n int64) bool {
+	if f.size == 0 {
+		return false
+	}
+	if f.config.MaxSize > 0 && f.size+n > f.config.MaxSize {
+		return true
+	}
+	return f.config.Interval > 0 && f.now().Sub(f.openedAt) >= f.config.Interval
+}
+
+func (f *RotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return fmt.Errorf("close log file: %w", err)
+	}
+	if err := os.Rename(f.config.Filename, f.backupName(f.now())); err != nil {
+		return fmt.Errorf("rename log file: %w", err)
+	}
+	if err := f.open(); err != nil {
+		return err
+	}
+	f.notifyMill()
+	return nil
+}
+
+func (f *RotatingFile) backupName(t time.Time) string {
+	prefix, ext := f.backupPrefix()
+	return prefix + t.UTC().Format(backupTimeFormat) + ext
+}
+
+// backupPrefix returns the prefix and extension of backup names: worker.log is backed up as worker-<time>.log.
+func (f *RotatingFile) backupPrefix() (string, string) {
+	ext := filepath.Ext(f.config.Filename)
+	return strings.TrimSuffix(f.config.Filename, ext) + "-", ext
+}
+
+func (f *RotatingFile) notifyMill() {
+	select {
+	case f.mill <- struct{}{}:
+	default:
+		// a run is already pending and will see the new backup
+	}
+}
+
+// runMill compresses and prunes backups after each rotation. Errors cannot be logged to the file
+// being rotated, so they are reported on stderr.
+func (f *RotatingFile) runMill() {
+	defer close(f.millDone)
+	for range f.mill {
+		if err := f.compressAndPrune(); err != nil {
+			fmt.Fprintf(os.Stderr, "rotate log file %s: %v\n", f.config.Filename, err)
+		}
+	}
+}
+
+func (f *RotatingFile) compressAndPrune() error {
+	backups, err := f.backups()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	if f.config.Compress {
+		for i, backup := range backups {
+			if strings.HasSuffix(backup, compressedSuffix) {
+				continue
+			}
+			if err := compressFile(backup); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			backups[i] = backup + compressedSuffix
+		}
+	}
+
+	if f.config.MaxBackups > 0 && len(backups) > f.config.MaxBackups {
+		for _, backup := range backups[f.config.MaxBackups:] {
+			if err := os.Remove(backup); err != nil {
+				errs = append(errs, fmt.Errorf("remove backup: %w", err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// backups returns the paths of the backups of the file, newest first.
+func (f *RotatingFile) backups() ([]string, error) {
+	entries, err := os.ReadDir(filepath.Dir(f.config.Filename))
+	if err != nil {
+		return nil, fmt.Errorf("list backups: %w", err)
+	}
+
+	prefix, ext := f.backupPrefix()
+	prefix = filepath.Base(prefix)
+	var backups []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		timestamp := strings.TrimSuffix(strings.TrimSuffix(name[len(prefix):], compressedSuffix), ext)
+		if _, err := time.Parse(backupTimeFormat, timestamp); err != nil {
+			continue
+		}
+		backups = append(backups, filepath.Join(filepath.Dir(f.config.Filename), name))
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(backups)))
+	return backups, nil
+}
+
+// compressFile gzips path to path.gz and removes path.
+func compressFile(path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("open backup: %w", err)
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(path+compressedSuffix, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, logFileMode)
+	if err != nil {
+		return fmt.Errorf("create compressed backup: %w", err)
+	}
+	zw := gzip.NewWriter(dst)
+	_, err = io.Copy(zw, src)
+	err = errors.Join(err, zw.Close(), dst.Close())
+	if err != nil {
+		_ = os.Remove(path + compressedSuffix)
+		return fmt.Errorf("compress backup: %w", err)
+	}
+
+	if err := os.Remove(path); err != nil {
+		return fmt.Errorf("remove compressed backup: %w", err)
+	}
+	return nil
+}
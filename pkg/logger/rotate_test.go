@@ -0,0 +1,178 @@
+package logger_test
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger"
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+// newRotatingFile returns a rotating file writing worker.log in a new directory, and that directory.
+func newRotatingFile(t *testing.T, config model.FileConfig) (*logger.RotatingFile, string) {
+	t.Helper()
+	dir := filepath.Join(t.TempDir(), "logs")
+	config.Filename = filepath.Join(dir, "worker.log")
+	file, err := logger.NewRotatingFile(&config)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = file.Close() })
+	return file, dir
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return string(data)
+}
+
+// backups returns the names of the backups in dir, oldest first.
+func backups(t *testing.T, dir string) []string {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), "worker-") {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestNewRotatingFile(t *testing.T) {
+	t.Parallel()
+	_, err := logger.NewRotatingFile(&model.FileConfig{})
+	require.Error(t, err)
+}
+
+func TestRotatingFile_RotateBySize(t *testing.T) {
+	t.Parallel()
+	file, dir := newRotatingFile(t, model.FileConfig{MaxSize: 10, MaxBackups: 2})
+
+	for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+		_, err := file.Write([]byte(line))
+		require.NoError(t, err)
+	}
+	require.NoError(t, file.Close())
+
+	assert.Equal(t, "fourth\n", readFile(t, filepath.Join(dir, "worker.log")))
+	names := backups(t, dir)
+	require.Len(t, names, 2)
+	assert.Equal(t, "second\n", readFile(t, filepath.Join(dir, names[0])))
+	assert.Equal(t, "third\n", readFile(t, filepath.Join(dir, names[1])))
+	for _, name := range names {
+		assert.Regexp(t, `^worker-\d{4}-\d{2}-\d{2}T\d{2}-\d{2}-\d{2}\.\d{9}\.log$`, name)
+	}
+}
+
+func TestRotatingFile_RotateByTime(t *testing.T) {
+	t.Parallel()
+	file, dir := newRotatingFile(t, model.FileConfig{Interval: 10 * time.Millisecond})
+
+	_, err := file.Write([]byte("first\n"))
+	require.NoError(t, err)
+	_, err = file.Write([]byte("second\n"))
+	require.NoError(t, err)
+	time.Sleep(20 * time.Millisecond)
+	_, err = file.Write([]byte("third\n"))
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	assert.Equal(t, "third\n", readFile(t, filepath.Join(dir, "worker.log")))
+	names := backups(t, dir)
+	require.Len(t, names, 1)
+	assert.Equal(t, "first\nsecond\n", readFile(t, filepath.Join(dir, names[0])))
+}
+
+func TestRotatingFile_Compress(t *testing.T) {
+	t.Parallel()
+	file, dir := newRotatingFile(t, model.FileConfig{Compress: true})
+
+	_, err := file.Write([]byte("compressed\n"))
+	require.NoError(t, err)
+	require.NoError(t, file.Rotate())
+	require.NoError(t, file.Close())
+
+	names := backups(t, dir)
+	require.Len(t, names, 1)
+	require.True(t, strings.HasSuffix(names[0], ".log.gz"), names[0])
+
+	compressed, err := os.Open(filepath.Join(dir, names[0]))
+	require.NoError(t, err)
+	defer compressed.Close()
+	zr, err := gzip.NewReader(compressed)
+	require.NoError(t, err)
+	data, err := io.ReadAll(zr)
+	require.NoError(t, err)
+	assert.Equal(t, "compressed\n", string(data))
+}
+
+func TestRotatingFile_ReopenOnSIGHUP(t *testing.T) {
+	t.Parallel()
+	file, dir := newRotatingFile(t, model.FileConfig{})
+	filename := filepath.Join(dir, "worker.log")
+
+	_, err := file.Write([]byte("before\n"))
+	require.NoError(t, err)
+	// rotated by an external tool
+	require.NoError(t, os.Rename(filename, filepath.Join(dir, "worker.log.1")))
+
+	process, err := os.FindProcess(os.Getpid())
+	require.NoError(t, err)
+	if err := process.Signal(syscall.SIGHUP); err != nil {
+		t.Skipf("SIGHUP not supported: %v", err)
+	}
+	require.Eventually(t, func() bool {
+		_, err := os.Stat(filename)
+		return err == nil
+	}, time.Second, time.Millisecond)
+
+	_, err = file.Write([]byte("after\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "before\n", readFile(t, filepath.Join(dir, "worker.log.1")))
+	assert.Equal(t, "after\n", readFile(t, filename))
+}
+
+func TestRotatingFile_ConcurrentWrites(t *testing.T) {
+	t.Parallel()
+	file, dir := newRotatingFile(t, model.FileConfig{MaxSize: 100})
+	log := logger.NewSlogLogger(&model.Config{Output: file, Format: "text"})
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 10 {
+				log.Info("concurrent")
+			}
+		}()
+	}
+	wg.Wait()
+	require.NoError(t, file.Close())
+
+	_, err := file.Write([]byte("closed\n"))
+	require.ErrorIs(t, err, os.ErrClosed)
+
+	lines := 0
+	for _, name := range append(backups(t, dir), "worker.log") {
+		for _, line := range strings.Split(strings.TrimSpace(readFile(t, filepath.Join(dir, name))), "\n") {
+			assert.Contains(t, line, `msg=concurrent`)
+			lines++
+		}
+	}
+	assert.Equal(t, 100, lines)
+}
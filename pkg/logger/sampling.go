@@ -0,0 +1,171 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+// message of the entry reporting the messages dropped during the previous sampling interval.
+const samplingDroppedMessage = "log messages dropped by sampling"
+
+// samplingHandler drops repeated messages according to a model.SamplingConfig.
+// Handlers derived with WithAttrs and WithGroup share the counters of the handler they derive from.
+// The number of dropped messages is reported when the interval ends, by the next message or by a timer
+// when no message follows, and by flush.
+type samplingHandler struct {
+	handler slog.Handler
+	state   *samplingState
+}
+
+type samplingKey struct {
+	level   slog.Level
+	message string
+}
+
+type samplingState struct {
+	mu          sync.Mutex
+	config      model.SamplingConfig
+	interval    time.Duration
+	root        slog.Handler
+	now         func() time.Time
+	windowStart time.Time
+	counts      map[samplingKey]int
+	dropped     int
+	// timer reports the dropped messages at the end of the interval if no message starts the next one.
+	timer *time.Timer
+}
+
+func newSamplingHandler(handler slog.Handler, config *model.SamplingConfig) *samplingHandler {
+	return &samplingHandler{
+		handler: handler,
+		state: &samplingState{
+			config:   *config,
+			interval: config.GetInterval(),
+			root:     handler,
+			now:      time.Now,
+			counts:   make(map[samplingKey]int),
+		},
+	}
+}
+
+func (h *samplingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.handler.Enabled(ctx, level)
+}
+
+func (h *samplingHandler) Handle(ctx context.Context, record slog.Record) error {
+	if h.state.config.ExemptErrors && record.Level >= slog.LevelError {
+		return h.forward(ctx, record)
+	}
+
+	keep, dropped := h.state.sample(samplingKey{level: record.Level, message: record.Message})
+	if dropped > 0 {
+		if err := h.state.reportDropped(ctx, dropped); err != nil {
+			return err
+		}
+	}
+	if !keep {
+		return nil
+	}
+	return h.forward(ctx, record)
+}
+
+//nolint:ireturn // implements slog.Handler interface
+func (h *samplingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &samplingHandler{handler: h.handler.WithAttrs(attrs), state: h.state}
+}
+
+//nolint:ireturn // implements slog.Handler interface
+func (h *samplingHandler) WithGroup(name string) slog.Handler {
+	return &samplingHandler{handler: h.handler.WithGroup(name), state: h.state}
+}
+
+func (h *samplingHandler) forward(ctx context.Context, record slog.Record) error {
+	if err := h.handler.Handle(ctx, record); err != nil {
+		return fmt.Errorf("handle sampled record: %w", err)
+	}
+	return nil
+}
+
+// sample counts a message and reports whether it should be logged, along with the number of
+// messages dropped during the previous interval when a new interval starts.
+func (s *samplingState) sample(key samplingKey) (bool, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dropped := 0
+	if now := s.now(); now.Sub(s.windowStart) >= s.interval {
+		dropped = s.dropped
+		s.dropped = 0
+		s.windowStart = now
+		clear(s.counts)
+	}
+
+	s.counts[key]++
+	n := s.counts[key]
+	keep := n <= s.config.Initial ||
+		(s.config.Thereafter > 0 && (n-s.config.Initial)%s.config.Thereafter == 0)
+	if !keep {
+		s.dropped++
+		if s.dropped == 1 {
+			s.stopTimer()
+			s.timer = time.AfterFunc(s.windowStart.Add(s.interval).Sub(s.now()), s.reportExpired)
+		}
+	}
+	return keep, dropped
+}
+
+// reportExpired reports the messages dropped during an interval that ended without a message starting the
+// next one, which then starts with the next message.
+func (s *samplingState) reportExpired() {
+	s.mu.Lock()
+	if s.dropped == 0 || s.now().Sub(s.windowStart) < s.interval {
+		s.mu.Unlock()
+		return
+	}
+	dropped := s.dropped
+	s.dropped = 0
+	s.windowStart = time.Time{}
+	clear(s.counts)
+	s.mu.Unlock()
+
+	// there is no caller to return the error to, as with the records written by an AsyncHandler
+	_ = s.reportDropped(context.Background(), dropped)
+}
+
+// flush reports the messages dropped so far during the current interval.
+func (s *samplingState) flush(ctx context.Context) error {
+	s.mu.Lock()
+	dropped := s.dropped
+	s.dropped = 0
+	s.stopTimer()
+	s.mu.Unlock()
+
+	if dropped == 0 {
+		return nil
+	}
+	return s.reportDropped(ctx, dropped)
+}
+
+func (s *samplingState) stopTimer() {
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+}
+
+func (s *samplingState) reportDropped(ctx context.Context, dropped int) error {
+	if !s.root.Enabled(ctx, slog.LevelWarn) {
+		return nil
+	}
+	record := slog.NewRecord(s.now(), slog.LevelWarn, samplingDroppedMessage, 0)
+	record.AddAttrs(slog.Int("dropped", dropped), slog.Duration("interval", s.interval))
+	if err := s.root.Handle(ctx, record); err != nil {
+		return fmt.Errorf("report dropped records: %w", err)
+	}
+	return nil
+}
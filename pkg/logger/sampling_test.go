@@ -0,0 +1,165 @@
+package logger_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger"
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+func TestSlogLogger_Sampling(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		sampling   model.SamplingConfig
+		log        func(log *logger.SlogLogger)
+		wantRetry  int
+		wantFailed int
+	}{
+		{
+			name:     "first N then 1 in M",
+			sampling: model.SamplingConfig{Initial: 3, Thereafter: 5, Interval: time.Hour},
+			log: func(log *logger.SlogLogger) {
+				for range 20 {
+					log.WithField("attempt", 1).Info("retrying")
+				}
+			},
+			// messages 1, 2, 3, 8, 13 and 18
+			wantRetry: 6,
+		},
+		{
+			name:     "drop all after initial",
+			sampling: model.SamplingConfig{Initial: 2, Interval: time.Hour},
+			log: func(log *logger.SlogLogger) {
+				for range 10 {
+					log.Info("retrying")
+				}
+			},
+			wantRetry: 2,
+		},
+		{
+			name:     "errors are sampled",
+			sampling: model.SamplingConfig{Initial: 1, Interval: time.Hour},
+			log: func(log *logger.SlogLogger) {
+				for range 10 {
+					log.Error("failed")
+				}
+			},
+			wantFailed: 1,
+		},
+		{
+			name:     "errors are exempt",
+			sampling: model.SamplingConfig{Initial: 1, Interval: time.Hour, ExemptErrors: true},
+			log: func(log *logger.SlogLogger) {
+				for range 10 {
+					log.Info("retrying")
+					log.Error("failed")
+				}
+			},
+			wantRetry:  1,
+			wantFailed: 10,
+		},
+	}
+	for _, tC := range tests {
+		tt := tC
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			output := new(strings.Builder)
+			tt.log(logger.NewSlogLogger(&model.Config{Output: output, Sampling: &tt.sampling}))
+
+			assert.Equal(t, tt.wantRetry, strings.Count(output.String(), `"msg":"retrying"`))
+			assert.Equal(t, tt.wantFailed, strings.Count(output.String(), `"msg":"failed"`))
+		})
+	}
+}
+
+func TestSlogLogger_SamplingReportsDropped(t *testing.T) {
+	t.Parallel()
+	output := new(syncBuilder)
+	log := logger.NewSlogLogger(&model.Config{
+		Output:   output,
+		Sampling: &model.SamplingConfig{Initial: 1, Interval: 50 * time.Millisecond},
+	})
+
+	for range 5 {
+		log.Info("retrying")
+	}
+	assert.NotContains(t, output.String(), "dropped")
+
+	time.Sleep(100 * time.Millisecond)
+	log.Info("retrying")
+
+	assert.Contains(t, output.String(), `"level":"WARN","msg":"log messages dropped by sampling","dropped":4,"interval":50000000`)
+	assert.Equal(t, 2, strings.Count(output.String(), `"msg":"retrying"`))
+}
+
+// syncBuilder is a strings.Builder safe for concurrent writes, such as those of the sampling timer.
+type syncBuilder struct {
+	mu sync.Mutex
+	sb strings.Builder
+}
+
+func (b *syncBuilder) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.sb.Write(p)
+}
+
+func (b *syncBuilder) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.sb.String()
+}
+
+func TestSlogLogger_SamplingReportsDroppedAfterBurst(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		interval time.Duration
+		end      func(t *testing.T, log *logger.SlogLogger)
+	}{
+		{
+			name:     "interval ends",
+			interval: 50 * time.Millisecond,
+			end:      func(*testing.T, *logger.SlogLogger) {},
+		},
+		{
+			name:     "logger closed",
+			interval: time.Hour,
+			end: func(t *testing.T, log *logger.SlogLogger) {
+				t.Helper()
+				require.NoError(t, log.Close(context.Background()))
+			},
+		},
+	}
+	for _, tC := range tests {
+		tt := tC
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			output := new(syncBuilder)
+			log := logger.NewSlogLogger(&model.Config{
+				Output:   output,
+				Sampling: &model.SamplingConfig{Initial: 1, Interval: tt.interval},
+			})
+
+			// the burst is not followed by any other message
+			for range 5 {
+				log.Info("retrying")
+			}
+			tt.end(t, log)
+
+			assert.Eventually(t, func() bool {
+				return strings.Contains(output.String(), `"msg":"log messages dropped by sampling","dropped":4`)
+			}, time.Second, 10*time.Millisecond)
+			assert.Equal(t, 1, strings.Count(output.String(), "dropped by sampling"))
+			assert.Equal(t, 1, strings.Count(output.String(), `"msg":"retrying"`))
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package logger
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+// NewConfig validates settings and returns the configuration they describe. The output is resolved to
+// os.Stdout, os.Stderr or a RotatingFile; the returned io.Closer closes that file, and does nothing for
+// the standard streams.
+func NewConfig(settings *model.Settings) (*model.Config, io.Closer, error) {
+	if err := settings.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("invalid log settings: %w", err)
+	}
+
+	config := &model.Config{
+		Level:           settings.Level,
+		Format:          settings.Format,
+		IncludeSource:   settings.Source,
+		ComponentLevels: settings.Components,
+	}
+	if async := settings.Async; async != nil {
+		// the overflow policy was checked by Validate
+		overflow, _ := model.ParseOverflowPolicy(async.Overflow)
+		config.Async = &model.AsyncConfig{QueueSize: async.QueueSize, Overflow: overflow}
+	}
+	switch strings.ToLower(settings.Output) {
+	case "", model.OutputStdout:
+		config.Output = os.Stdout
+		return config, nopCloser{}, nil
+	case model.OutputStderr:
+		config.Output = os.Stderr
+		return config, nopCloser{}, nil
+	}
+
+	fileConfig := &model.FileConfig{Filename: settings.Output}
+	if rotation := settings.Rotation; rotation != nil {
+		// the interval was checked by Validate
+		fileConfig.Interval, _ = rotation.GetInterval()
+		fileConfig.MaxSize = rotation.MaxSize
+		fileConfig.MaxBackups = rotation.MaxBackups
+		fileConfig.Compress = rotation.Compress
+	}
+	file, err := NewRotatingFile(fileConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	config.Output = file
+	return config, file, nil
+}
+
+type nopCloser struct{}
+
+func (nopCloser) Close() error { return nil }
@@ -0,0 +1,75 @@
+package logger_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger"
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+func TestNewConfig(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		output     string
+		wantOutput *os.File
+	}{
+		{name: "default", wantOutput: os.Stdout},
+		{name: "stdout", output: "stdout", wantOutput: os.Stdout},
+		{name: "stderr", output: "STDERR", wantOutput: os.Stderr},
+	}
+	for _, tC := range tests {
+		tt := tC
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			config, closer, err := logger.NewConfig(&model.Settings{Level: "debug", Output: tt.output, Source: true})
+			require.NoError(t, err)
+			assert.Same(t, tt.wantOutput, config.Output)
+			assert.Equal(t, model.DebugLevel, config.GetLevel())
+			assert.True(t, config.IncludeSource)
+			require.NoError(t, closer.Close())
+		})
+	}
+}
+
+func TestNewConfig_File(t *testing.T) {
+	t.Parallel()
+	filename := filepath.Join(t.TempDir(), "worker.log")
+	config, closer, err := logger.NewConfig(&model.Settings{
+		Output:   filename,
+		Format:   "text",
+		Rotation: &model.RotationSettings{Interval: "1h", MaxBackups: 2},
+	})
+	require.NoError(t, err)
+	file, ok := config.Output.(*logger.RotatingFile)
+	require.True(t, ok)
+
+	logger.NewSlogLogger(config).Info("to file")
+	require.NoError(t, closer.Close())
+	assert.Contains(t, readFile(t, filename), "msg=\"to file\"")
+	_, err = file.Write([]byte("closed"))
+	require.ErrorIs(t, err, os.ErrClosed)
+}
+
+func TestNewConfig_Async(t *testing.T) {
+	t.Parallel()
+	config, _, err := logger.NewConfig(&model.Settings{Async: &model.AsyncSettings{Overflow: "DROP_NEWEST"}})
+	require.NoError(t, err)
+	assert.Equal(t, &model.AsyncConfig{Overflow: model.OverflowDropNewest}, config.Async)
+	assert.Equal(t, model.DefaultAsyncQueueSize, config.Async.GetQueueSize())
+}
+
+func TestNewConfig_Invalid(t *testing.T) {
+	t.Parallel()
+	_, _, err := logger.NewConfig(&model.Settings{
+		Level:    "verbose",
+		Rotation: &model.RotationSettings{Interval: "one hour"},
+	})
+	require.ErrorIs(t, err, model.ErrUnknownLevel)
+	assert.ErrorContains(t, err, "rotation interval")
+}
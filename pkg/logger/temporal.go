@@ -0,0 +1,175 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"runtime"
+	"time"
+
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/converter"
+	sdkLog "go.temporal.io/sdk/log"
+	"go.temporal.io/sdk/temporal"
+)
+
+// number of stack frames between runtime.Callers and the code calling a TemporalLogger method.
+const temporalLoggerCallerDepth = 3
+
+// TemporalLogger adapts SlogLogger to the logger interfaces of the Temporal SDK, so that SDK
+// internal logs share the handler, level and custom level labels of the SlogLogger.
+// It can be set as client.Options.Logger.
+type TemporalLogger struct {
+	entry *slog.Logger
+	depth int
+}
+
+var (
+	_ sdkLog.Logger          = (*TemporalLogger)(nil)
+	_ sdkLog.WithLogger      = (*TemporalLogger)(nil)
+	_ sdkLog.WithSkipCallers = (*TemporalLogger)(nil)
+)
+
+// NewTemporalLogger returns a TemporalLogger writing through log.
+func NewTemporalLogger(log *SlogLogger) *TemporalLogger {
+	return &TemporalLogger{
+		entry: log.entry,
+		depth: temporalLoggerCallerDepth,
+	}
+}
+
+func (t *TemporalLogger) Debug(msg string, keyvals ...interface{}) {
+	t.log(slog.LevelDebug, msg, keyvals)
+}
+
+func (t *TemporalLogger) Info(msg string, keyvals ...interface{}) {
+	t.log(slog.LevelInfo, msg, keyvals)
+}
+
+func (t *TemporalLogger) Warn(msg string, keyvals ...interface{}) {
+	t.log(slog.LevelWarn, msg, keyvals)
+}
+
+func (t *TemporalLogger) Error(msg string, keyvals ...interface{}) {
+	t.log(slog.LevelError, msg, keyvals)
+}
+
+// With returns a logger that prepends keyvals to every entry.
+//
+//nolint:ireturn // implements sdkLog.WithLogger interface
+func (t *TemporalLogger) With(keyvals ...interface{}) sdkLog.Logger {
+	return &TemporalLogger{
+		entry: t.entry.With(keyvals...),
+		depth: t.depth,
+	}
+}
+
+// WithCallerSkip returns a logger that attributes entries to a caller depth frames further up the stack.
+//
+//nolint:ireturn // implements sdkLog.WithSkipCallers interface
+func (t *TemporalLogger) WithCallerSkip(depth int) sdkLog.Logger {
+	return &TemporalLogger{
+		entry: t.entry,
+		depth: t.depth + depth,
+	}
+}
+
+// log builds the record itself instead of calling slog.Logger methods, so that the source
+// attribute points at the caller of the adapter rather than at the adapter.
+func (t *TemporalLogger) log(level slog.Level, msg string, keyvals []interface{}) {
+	ctx := context.Background()
+	if !t.entry.Enabled(ctx, level) {
+		return
+	}
+
+	var pcs [1]uintptr
+	runtime.Callers(t.depth, pcs[:])
+
+	record := slog.NewRecord(time.Now(), level, msg, pcs[0])
+	record.Add(keyvals...)
+	_ = t.entry.Handler().Handle(ctx, record)
+}
+
+// ExtractActivityInfo is a model.ContextExtractor returning the identity of the Temporal activity
+// running with the context: its type, ID and attempt, and the workflow execution it belongs to.
+func ExtractActivityInfo(ctx context.Context) []slog.Attr {
+	if !activity.IsActivity(ctx) {
+		return nil
+	}
+	info := activity.GetInfo(ctx)
+	return []slog.Attr{
+		slog.String("activity_type", info.ActivityType.Name),
+		slog.String("activity_id", info.ActivityID),
+		slog.Int("attempt", int(info.Attempt)),
+		slog.String("workflow_id", info.WorkflowExecution.ID),
+		slog.String("run_id", info.WorkflowExecution.RunID),
+	}
+}
+
+// temporalErrorAttrs returns a group for each Temporal failure in the chain of err: "application" with the
+// type, retryability and details, decoded with dataConverter, of an ApplicationError, "activity" with the
+// identity of the activity of an ActivityError and "child_workflow" with the execution of a
+// ChildWorkflowExecutionError.
+func temporalErrorAttrs(err error, dataConverter converter.DataConverter) []slog.Attr {
+	var attrs []slog.Attr
+
+	var applicationErr *temporal.ApplicationError
+	if errors.As(err, &applicationErr) {
+		group := []slog.Attr{
+			slog.String("type", applicationErr.Type()),
+			slog.Bool("non_retryable", applicationErr.NonRetryable()),
+		}
+		if applicationErr.HasDetails() {
+			group = append(group, slog.Any("details", applicationErrorDetails(applicationErr, dataConverter)))
+		}
+		attrs = append(attrs, slog.Attr{Key: "application", Value: slog.GroupValue(group...)})
+	}
+
+	var activityErr *temporal.ActivityError
+	if errors.As(err, &activityErr) {
+		attrs = append(attrs, slog.Group("activity",
+			slog.String("type", activityErr.ActivityType().GetName()),
+			slog.String("id", activityErr.ActivityID()),
+			slog.Int64("scheduled_event_id", activityErr.ScheduledEventID()),
+			slog.Int64("started_event_id", activityErr.StartedEventID()),
+			slog.String("identity", activityErr.Identity()),
+			slog.String("retry_state", activityErr.RetryState().String()),
+		))
+	}
+
+	var childErr *temporal.ChildWorkflowExecutionError
+	if errors.As(err, &childErr) {
+		// the execution of the child workflow is only exposed through its failure
+		info := temporal.GetDefaultFailureConverter().ErrorToFailure(childErr).GetChildWorkflowExecutionFailureInfo()
+		attrs = append(attrs, slog.Group("child_workflow",
+			slog.String("namespace", info.GetNamespace()),
+			slog.String("type", info.GetWorkflowType().GetName()),
+			slog.String("workflow_id", info.GetWorkflowExecution().GetWorkflowId()),
+			slog.String("run_id", info.GetWorkflowExecution().GetRunId()),
+			slog.Int64("initiated_event_id", info.GetInitiatedEventId()),
+			slog.Int64("started_event_id", info.GetStartedEventId()),
+			slog.String("retry_state", info.GetRetryState().String()),
+		))
+	}
+
+	return attrs
+}
+
+// applicationErrorDetails decodes the details of err with dataConverter, which should be the data converter
+// of the client so that details encoded by a codec are decoded. Details that cannot be decoded are replaced by
+// the decoding error. The details are then redacted by the handlers as the other attributes.
+func applicationErrorDetails(err *temporal.ApplicationError, dataConverter converter.DataConverter) []interface{} {
+	failureConverter := temporal.NewDefaultFailureConverter(temporal.DefaultFailureConverterOptions{
+		DataConverter: dataConverter,
+	})
+	payloads := failureConverter.ErrorToFailure(err).GetApplicationFailureInfo().GetDetails().GetPayloads()
+	details := make([]interface{}, 0, len(payloads))
+	for _, payload := range payloads {
+		var detail interface{}
+		if decodeErr := dataConverter.FromPayload(payload, &detail); decodeErr != nil {
+			detail = decodeErr.Error()
+		}
+		details = append(details, detail)
+	}
+	return details
+}
@@ -0,0 +1,111 @@
+package logger_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/activity"
+	sdkLog "go.temporal.io/sdk/log"
+	"go.temporal.io/sdk/testsuite"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger"
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+func TestTemporalLogger_Levels(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		log   func(l sdkLog.Logger)
+		level model.Level
+	}{
+		{name: "debug", log: func(l sdkLog.Logger) { l.Debug(testMsgText) }, level: model.DebugLevel},
+		{name: "info", log: func(l sdkLog.Logger) { l.Info(testMsgText) }, level: model.InfoLevel},
+		{name: "warn", log: func(l sdkLog.Logger) { l.Warn(testMsgText) }, level: model.WarnLevel},
+		{name: "error", log: func(l sdkLog.Logger) { l.Error(testMsgText) }, level: model.ErrorLevel},
+	}
+	for _, tC := range tests {
+		tt := tC
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			slogLogger, output := makeTestLogger()
+			slogLogger.SetLevel(model.DebugLevel)
+
+			tt.log(logger.NewTemporalLogger(slogLogger))
+			outputMustMatch(t, "TemporalLogger", output.String(), []string{testString(tt.level)})
+		})
+	}
+}
+
+func TestTemporalLogger_SharesLevel(t *testing.T) {
+	t.Parallel()
+	slogLogger, output := makeTestLogger()
+	temporalLogger := logger.NewTemporalLogger(slogLogger)
+
+	temporalLogger.Debug("debug msg")
+	assert.NotContains(t, output.String(), "debug msg")
+
+	slogLogger.SetLevel(model.DebugLevel)
+	temporalLogger.Debug("debug msg")
+	assert.Contains(t, output.String(), "debug msg")
+}
+
+func TestTemporalLogger_With(t *testing.T) {
+	t.Parallel()
+	slogLogger, output := makeTestLogger()
+
+	sdkLog.With(logger.NewTemporalLogger(slogLogger), "key", "demo").Info(testMsgText, "other", "value")
+	outputMustMatch(t, "TemporalLogger.With", output.String(), []string{
+		testString(model.InfoLevel, "key", "demo", "other", "value"),
+	})
+}
+
+func TestTemporalLogger_Source(t *testing.T) {
+	t.Parallel()
+	output := new(strings.Builder)
+	slogLogger := logger.NewSlogLogger(&model.Config{Output: output, IncludeSource: true, Level: model.InfoLevel.String()})
+
+	logger.NewTemporalLogger(slogLogger).Info(testMsgText)
+	sdkLog.With(logger.NewTemporalLogger(slogLogger), "key", "demo").Info(testMsgText)
+	logThroughHelper(sdkLog.Skip(logger.NewTemporalLogger(slogLogger), 1))
+
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	assert.Len(t, lines, 3)
+	for _, line := range lines {
+		assert.Contains(t, line, `"function":"github.com/nash-567/goTemporalLoom/pkg/logger_test.TestTemporalLogger_Source"`)
+		assert.Contains(t, line, "temporal_test.go")
+	}
+}
+
+// logThroughHelper logs from a helper so that the caller skip can attribute the entry to the test.
+func logThroughHelper(l sdkLog.Logger) {
+	l.Info(testMsgText)
+}
+
+func TestExtractActivityInfo(t *testing.T) {
+	t.Parallel()
+	assert.Nil(t, logger.ExtractActivityInfo(context.Background()))
+
+	output := new(strings.Builder)
+	log := logger.NewSlogLogger(&model.Config{
+		Output:            output,
+		ContextExtractors: []model.ContextExtractor{logger.ExtractActivityInfo},
+	})
+	chargeActivity := func(ctx context.Context) error {
+		log.InfoCtx(ctx, testMsgText)
+		return nil
+	}
+
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestActivityEnvironment()
+	env.RegisterActivityWithOptions(chargeActivity, activity.RegisterOptions{Name: "ChargeActivity"})
+	_, err := env.ExecuteActivity("ChargeActivity")
+	require.NoError(t, err)
+
+	assert.Contains(t, output.String(), `"activity_type":"ChargeActivity"`)
+	assert.Contains(t, output.String(), `"attempt":1`)
+	assert.Contains(t, output.String(), `"workflow_id":"default-test-workflow-id"`)
+}
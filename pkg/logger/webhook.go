@@ -0,0 +1,122 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"runtime"
+	"strconv"
+	"time"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+// WebhookHook posts the records of its levels as JSON to an HTTP endpoint, such as the intake of an error
+// tracker. Each record is posted with one request, so the hook is usually wrapped with NewAsyncHook and
+// NewDedupHook.
+type WebhookHook struct {
+	config model.WebhookConfig
+}
+
+var _ Hook = (*WebhookHook)(nil)
+
+// WebhookPayload is the JSON body posted by WebhookHook.
+type WebhookPayload struct {
+	Time    time.Time `json:"time"`
+	Level   string    `json:"level"`
+	Message string    `json:"message"`
+	// Source is the file and line the record was logged from, when known.
+	Source string `json:"source,omitempty"`
+	// Fields are the attributes of the record, groups being nested objects and errors their message.
+	Fields map[string]interface{} `json:"fields,omitempty"`
+}
+
+func NewWebhookHook(config *model.WebhookConfig) *WebhookHook {
+	return &WebhookHook{config: *config}
+}
+
+func (h *WebhookHook) Levels() []model.Level {
+	return h.config.GetLevels()
+}
+
+// Fire posts record and fails unless the endpoint answers with a 2xx status.
+func (h *WebhookHook) Fire(record slog.Record) error {
+	body, err := json.Marshal(newWebhookPayload(record))
+	if err != nil {
+		return fmt.Errorf("encode webhook payload: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.config.GetTimeout())
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range h.config.Headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := h.config.GetClient().Do(req)
+	if err != nil {
+		return fmt.Errorf("post webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	// drain the body so that the connection can be reused
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("post webhook: unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+func newWebhookPayload(record slog.Record) *WebhookPayload {
+	payload := &WebhookPayload{
+		Time:    record.Time,
+		Level:   levelLabel(record.Level),
+		Message: record.Message,
+	}
+	if record.PC != 0 {
+		frame, _ := runtime.CallersFrames([]uintptr{record.PC}).Next()
+		payload.Source = frame.File + ":" + strconv.Itoa(frame.Line)
+	}
+	var attrs []slog.Attr
+	record.Attrs(func(a slog.Attr) bool {
+		attrs = append(attrs, a)
+		return true
+	})
+	if len(attrs) > 0 {
+		payload.Fields = attrsToMap(attrs)
+	}
+	return payload
+}
+
+// attrsToMap converts attrs to values encoded by encoding/json as the JSON handler encodes them.
+func attrsToMap(attrs []slog.Attr) map[string]interface{} {
+	fields := make(map[string]interface{}, len(attrs))
+	for _, a := range attrs {
+		value := a.Value.Resolve()
+		if value.Kind() == slog.KindGroup {
+			fields[a.Key] = attrsToMap(value.Group())
+			continue
+		}
+		if err, ok := value.Any().(error); ok {
+			fields[a.Key] = err.Error()
+			continue
+		}
+		fields[a.Key] = value.Any()
+	}
+	return fields
+}
+
+// levelLabel returns the label of level, as written by the format handlers.
+func levelLabel(level slog.Level) string {
+	if label, ok := getCustomLevelMap()[level]; ok {
+		return label
+	}
+	return level.String()
+}
@@ -0,0 +1,103 @@
+package logger_test
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger"
+	"github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+// webhookServer records the payloads posted to it.
+type webhookServer struct {
+	*httptest.Server
+	status   int
+	mu       sync.Mutex
+	payloads []logger.WebhookPayload
+	headers  []http.Header
+}
+
+func newWebhookServer(t *testing.T, status int) *webhookServer {
+	t.Helper()
+	server := &webhookServer{status: status}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload logger.WebhookPayload
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		server.mu.Lock()
+		server.payloads = append(server.payloads, payload)
+		server.headers = append(server.headers, r.Header.Clone())
+		server.mu.Unlock()
+		w.WriteHeader(server.status)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func (s *webhookServer) Payloads() []logger.WebhookPayload {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]logger.WebhookPayload(nil), s.payloads...)
+}
+
+func (s *webhookServer) Headers() []http.Header {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]http.Header(nil), s.headers...)
+}
+
+func TestWebhookHook(t *testing.T) {
+	t.Parallel()
+	server := newWebhookServer(t, http.StatusAccepted)
+	hook := logger.NewWebhookHook(&model.WebhookConfig{
+		URL:     server.URL,
+		Headers: map[string]string{"Authorization": "Bearer token"},
+	})
+	assert.Equal(t, []model.Level{model.ErrorLevel, model.FatalLevel}, hook.Levels())
+
+	log := logger.NewSlogLogger(&model.Config{Output: new(strings.Builder), FatalAction: model.FatalPanic})
+	log.AddHook(hook)
+	log.Named("payments").WithField("order", 42).WithError(errors.New("card declined")).Error("charge failed")
+	log.Warn("not posted")
+	require.Panics(t, func() { log.Fatal("out of memory") })
+
+	payloads, headers := server.Payloads(), server.Headers()
+	require.Len(t, payloads, 2)
+	payload := payloads[0]
+	assert.Equal(t, "ERROR", payload.Level)
+	assert.Equal(t, "charge failed", payload.Message)
+	assert.WithinDuration(t, time.Now(), payload.Time, time.Minute)
+	assert.Contains(t, payload.Source, ".go:")
+	assert.Equal(t, map[string]interface{}{
+		"logger": "payments",
+		"order":  float64(42),
+		"error":  "card declined",
+	}, payload.Fields)
+	assert.Equal(t, "FATAL", payloads[1].Level)
+	assert.Equal(t, "Bearer token", headers[0].Get("Authorization"))
+	assert.Equal(t, "application/json", headers[0].Get("Content-Type"))
+}
+
+func TestWebhookHook_Status(t *testing.T) {
+	t.Parallel()
+	server := newWebhookServer(t, http.StatusServiceUnavailable)
+	hook := logger.NewWebhookHook(&model.WebhookConfig{URL: server.URL, Levels: []model.Level{model.WarnLevel}})
+
+	err := hook.Fire(slog.NewRecord(time.Now(), slog.LevelWarn, "disk almost full", 0))
+	require.ErrorContains(t, err, "503 Service Unavailable")
+	payloads := server.Payloads()
+	require.Len(t, payloads, 1)
+	assert.Nil(t, payloads[0].Fields)
+}
@@ -0,0 +1,131 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/codec/model"
+)
+
+const (
+	dirPermission  = 0o750
+	filePermission = 0o600
+)
+
+var errInvalidKey = errors.New("invalid blob key")
+
+// FileStore is a BlobStore that keeps every blob in its own file inside a directory.
+// The modification time of a file is used as the time the blob was stored.
+type FileStore struct {
+	dir string
+}
+
+// NewFileStore returns a FileStore rooted at dir, creating the directory if needed.
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, dirPermission); err != nil {
+		return nil, fmt.Errorf("create blob directory: %w", err)
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+func (s *FileStore) Put(_ context.Context, key string, data []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file first so that readers never observe a partial blob.
+	tmp, err := os.CreateTemp(s.dir, ".tmp-"+key+"-*")
+	if err != nil {
+		return fmt.Errorf("create blob file: %w", err)
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck // already renamed on success
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("write blob file: %w", err)
+	}
+	if err := tmp.Chmod(filePermission); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("chmod blob file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close blob file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("rename blob file: %w", err)
+	}
+	return nil
+}
+
+func (s *FileStore) Get(_ context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, model.ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read blob file: %w", err)
+	}
+	return data, nil
+}
+
+func (s *FileStore) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("remove blob file: %w", err)
+	}
+	return nil
+}
+
+func (s *FileStore) DeleteExpired(ctx context.Context, before time.Time) (int, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return 0, fmt.Errorf("read blob directory: %w", err)
+	}
+
+	deleted := 0
+	for _, entry := range entries {
+		if err := ctx.Err(); err != nil {
+			return deleted, fmt.Errorf("delete expired blobs: %w", err)
+		}
+		if entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return deleted, fmt.Errorf("stat blob file: %w", err)
+		}
+		if !info.ModTime().Before(before) {
+			continue
+		}
+		if err := os.Remove(filepath.Join(s.dir, entry.Name())); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return deleted, fmt.Errorf("remove blob file: %w", err)
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+func (s *FileStore) path(key string) (string, error) {
+	if key == "" || key != filepath.Base(key) || key[0] == '.' {
+		return "", fmt.Errorf("%w: %q", errInvalidKey, key)
+	}
+	return filepath.Join(s.dir, key), nil
+}
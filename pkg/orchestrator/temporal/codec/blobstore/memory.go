@@ -0,0 +1,71 @@
+package blobstore
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/codec/model"
+)
+
+type memoryBlob struct {
+	data     []byte
+	storedAt time.Time
+}
+
+// MemoryStore is a BlobStore that keeps blobs in process memory.
+// It is meant for tests and single process setups.
+type MemoryStore struct {
+	mu    sync.RWMutex
+	blobs map[string]memoryBlob
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		blobs: make(map[string]memoryBlob),
+	}
+}
+
+func (s *MemoryStore) Put(_ context.Context, key string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.blobs[key] = memoryBlob{
+		data:     append([]byte(nil), data...),
+		storedAt: time.Now(),
+	}
+	return nil
+}
+
+func (s *MemoryStore) Get(_ context.Context, key string) ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	blob, ok := s.blobs[key]
+	if !ok {
+		return nil, model.ErrBlobNotFound
+	}
+	return append([]byte(nil), blob.data...), nil
+}
+
+func (s *MemoryStore) Delete(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.blobs, key)
+	return nil
+}
+
+func (s *MemoryStore) DeleteExpired(_ context.Context, before time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	deleted := 0
+	for key, blob := range s.blobs {
+		if blob.storedAt.Before(before) {
+			delete(s.blobs, key)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
@@ -0,0 +1,86 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/codec/blobstore"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/codec/model"
+)
+
+func newStores(t *testing.T) map[string]model.BlobStore {
+	t.Helper()
+	fileStore, err := blobstore.NewFileStore(t.TempDir())
+	require.NoError(t, err)
+	return map[string]model.BlobStore{
+		"memory": blobstore.NewMemoryStore(),
+		"file":   fileStore,
+	}
+}
+
+func TestBlobStore_PutGetDelete(t *testing.T) {
+	t.Parallel()
+	for name, s := range newStores(t) {
+		store := s
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			_, err := store.Get(ctx, "missing")
+			require.ErrorIs(t, err, model.ErrBlobNotFound)
+
+			require.NoError(t, store.Put(ctx, "key", []byte("value")))
+			got, err := store.Get(ctx, "key")
+			require.NoError(t, err)
+			assert.Equal(t, []byte("value"), got)
+
+			require.NoError(t, store.Put(ctx, "key", []byte("replaced")))
+			got, err = store.Get(ctx, "key")
+			require.NoError(t, err)
+			assert.Equal(t, []byte("replaced"), got)
+
+			require.NoError(t, store.Delete(ctx, "key"))
+			require.NoError(t, store.Delete(ctx, "key"))
+			_, err = store.Get(ctx, "key")
+			require.ErrorIs(t, err, model.ErrBlobNotFound)
+		})
+	}
+}
+
+func TestBlobStore_DeleteExpired(t *testing.T) {
+	t.Parallel()
+	for name, s := range newStores(t) {
+		store := s
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			require.NoError(t, store.Put(ctx, "a", []byte("a")))
+			require.NoError(t, store.Put(ctx, "b", []byte("b")))
+
+			deleted, err := store.DeleteExpired(ctx, time.Now().Add(-time.Hour))
+			require.NoError(t, err)
+			assert.Equal(t, 0, deleted)
+
+			deleted, err = store.DeleteExpired(ctx, time.Now().Add(time.Hour))
+			require.NoError(t, err)
+			assert.Equal(t, 2, deleted)
+
+			_, err = store.Get(ctx, "a")
+			require.ErrorIs(t, err, model.ErrBlobNotFound)
+		})
+	}
+}
+
+func TestFileStore_InvalidKey(t *testing.T) {
+	t.Parallel()
+	store, err := blobstore.NewFileStore(t.TempDir())
+	require.NoError(t, err)
+
+	for _, key := range []string{"", "../escape", "nested/key", ".hidden"} {
+		assert.Error(t, store.Put(context.Background(), key, []byte("x")), key)
+	}
+}
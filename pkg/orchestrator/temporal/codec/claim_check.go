@@ -0,0 +1,151 @@
+package codec
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/sdk/converter"
+	"google.golang.org/protobuf/proto"
+
+	logModel "github.com/nash-567/goTemporalLoom/pkg/logger/model"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/codec/model"
+)
+
+// MetadataEncodingClaimCheck marks a payload whose content was moved to a BlobStore.
+const MetadataEncodingClaimCheck = "claim-check/sha256"
+
+var errChecksumMismatch = errors.New("checksum mismatch")
+
+// claimCheckReference is stored in history in place of an offloaded payload.
+type claimCheckReference struct {
+	Key    string `json:"key"`
+	SHA256 string `json:"sha256"`
+	Size   int    `json:"size"`
+}
+
+type claimCheckCodec struct {
+	store     model.BlobStore
+	threshold int
+	timeout   time.Duration
+}
+
+// NewClaimCheckCodec returns a codec that moves payloads larger than the configured threshold
+// into the configured BlobStore and replaces them with a reference. Blobs are keyed by the
+// SHA-256 of their content, which is verified again when the payload is decoded.
+//
+//nolint:ireturn // converter.PayloadCodec is the type expected by the SDK
+func NewClaimCheckCodec(config *model.ClaimCheckConfig) converter.PayloadCodec {
+	return &claimCheckCodec{
+		store:     config.Store,
+		threshold: config.GetThreshold(),
+		timeout:   config.GetTimeout(),
+	}
+}
+
+func (c *claimCheckCodec) Encode(payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+	result := make([]*commonpb.Payload, len(payloads))
+	for i, p := range payloads {
+		data, err := proto.Marshal(p)
+		if err != nil {
+			return payloads, fmt.Errorf("marshal payload: %w", err)
+		}
+		if len(data) <= c.threshold {
+			result[i] = p
+			continue
+		}
+
+		sum := sha256.Sum256(data)
+		ref := claimCheckReference{
+			Key:    hex.EncodeToString(sum[:]),
+			SHA256: hex.EncodeToString(sum[:]),
+			Size:   len(data),
+		}
+		if err := c.withTimeout(func(ctx context.Context) error {
+			return c.store.Put(ctx, ref.Key, data)
+		}); err != nil {
+			return payloads, fmt.Errorf("store payload %s: %w", ref.Key, err)
+		}
+
+		refData, err := json.Marshal(ref)
+		if err != nil {
+			return payloads, fmt.Errorf("marshal claim check reference: %w", err)
+		}
+		result[i] = &commonpb.Payload{
+			Metadata: map[string][]byte{converter.MetadataEncoding: []byte(MetadataEncodingClaimCheck)},
+			Data:     refData,
+		}
+	}
+	return result, nil
+}
+
+func (c *claimCheckCodec) Decode(payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+	result := make([]*commonpb.Payload, len(payloads))
+	for i, p := range payloads {
+		if string(p.GetMetadata()[converter.MetadataEncoding]) != MetadataEncodingClaimCheck {
+			result[i] = p
+			continue
+		}
+
+		var ref claimCheckReference
+		if err := json.Unmarshal(p.GetData(), &ref); err != nil {
+			return payloads, fmt.Errorf("unmarshal claim check reference: %w", err)
+		}
+
+		var data []byte
+		if err := c.withTimeout(func(ctx context.Context) error {
+			var err error
+			data, err = c.store.Get(ctx, ref.Key)
+			return err
+		}); err != nil {
+			return payloads, fmt.Errorf("load payload %s: %w", ref.Key, err)
+		}
+
+		sum := sha256.Sum256(data)
+		if got := hex.EncodeToString(sum[:]); got != ref.SHA256 {
+			return payloads, fmt.Errorf("load payload %s: %w: got %s", ref.Key, errChecksumMismatch, got)
+		}
+
+		original := &commonpb.Payload{}
+		if err := proto.Unmarshal(data, original); err != nil {
+			return payloads, fmt.Errorf("unmarshal payload %s: %w", ref.Key, err)
+		}
+		result[i] = original
+	}
+	return result, nil
+}
+
+func (c *claimCheckCodec) withTimeout(fn func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	return fn(ctx)
+}
+
+// CollectGarbage deletes blobs that were stored more than ttl ago, then repeats every interval
+// until ctx is done. It is meant to run in its own goroutine next to a worker or the codec server.
+// Blobs are content addressed, so storing an identical payload again renews its lifetime.
+func CollectGarbage(ctx context.Context, store model.BlobStore, ttl, interval time.Duration, log logModel.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		deleted, err := store.DeleteExpired(ctx, time.Now().Add(-ttl))
+		switch {
+		case err != nil && ctx.Err() == nil:
+			log.WithError(err).Error("claim check garbage collection failed")
+		case deleted > 0:
+			log.WithField("deleted", deleted).Info("claim check garbage collection removed expired blobs")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
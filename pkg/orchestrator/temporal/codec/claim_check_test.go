@@ -0,0 +1,97 @@
+package codec_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/sdk/converter"
+
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/codec"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/codec/blobstore"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/codec/model"
+)
+
+func TestClaimCheckCodec(t *testing.T) {
+	t.Parallel()
+	store := blobstore.NewMemoryStore()
+	dc := codec.NewDataConverter(&model.Config{
+		ClaimCheck: &model.ClaimCheckConfig{Store: store, Threshold: 64},
+	})
+
+	small, err := dc.ToPayload("small")
+	require.NoError(t, err)
+	assert.NotEqual(t, codec.MetadataEncodingClaimCheck, string(small.GetMetadata()[converter.MetadataEncoding]))
+
+	large := strings.Repeat("large payload ", 20)
+	ref, err := dc.ToPayload(large)
+	require.NoError(t, err)
+	assert.Equal(t, codec.MetadataEncodingClaimCheck, string(ref.GetMetadata()[converter.MetadataEncoding]))
+	assert.Less(t, len(ref.GetData()), len(large))
+
+	var got string
+	require.NoError(t, dc.FromPayload(ref, &got))
+	assert.Equal(t, large, got)
+}
+
+func TestClaimCheckCodec_Decode(t *testing.T) {
+	t.Parallel()
+	large := &commonpb.Payload{Data: []byte(strings.Repeat("x", 100))}
+
+	tests := []struct {
+		name    string
+		tamper  func(t *testing.T, store model.BlobStore, key string)
+		wantErr string
+	}{
+		{
+			name:   "intact blob",
+			tamper: func(*testing.T, model.BlobStore, string) {},
+		},
+		{
+			name: "modified blob",
+			tamper: func(t *testing.T, store model.BlobStore, key string) {
+				t.Helper()
+				require.NoError(t, store.Put(context.Background(), key, []byte("tampered")))
+			},
+			wantErr: "checksum mismatch",
+		},
+		{
+			name: "expired blob",
+			tamper: func(t *testing.T, store model.BlobStore, key string) {
+				t.Helper()
+				require.NoError(t, store.Delete(context.Background(), key))
+			},
+			wantErr: model.ErrBlobNotFound.Error(),
+		},
+	}
+	for _, tC := range tests {
+		tt := tC
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			store := blobstore.NewMemoryStore()
+			cc := codec.NewClaimCheckCodec(&model.ClaimCheckConfig{Store: store, Threshold: 10})
+
+			encoded, err := cc.Encode([]*commonpb.Payload{large})
+			require.NoError(t, err)
+			require.Len(t, encoded, 1)
+
+			var ref struct {
+				Key string `json:"key"`
+			}
+			require.NoError(t, json.Unmarshal(encoded[0].GetData(), &ref))
+			tt.tamper(t, store, ref.Key)
+
+			decoded, err := cc.Decode(encoded)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, large.GetData(), decoded[0].GetData())
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package codec
+
+import (
+	"go.temporal.io/sdk/converter"
+
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/codec/model"
+)
+
+// NewChain returns the payload codecs described by config.
+// On encode the codecs are applied last to first, on decode first to last,
+// so payloads are compressed before the claim check decides whether to offload them.
+func NewChain(config *model.Config) []converter.PayloadCodec {
+	codecs := make([]converter.PayloadCodec, 0)
+	if config.ClaimCheck != nil {
+		codecs = append(codecs, NewClaimCheckCodec(config.ClaimCheck))
+	}
+	if config.Compression {
+		codecs = append(codecs, converter.NewZlibCodec(converter.ZlibCodecOptions{}))
+	}
+	return codecs
+}
+
+// NewDataConverter wraps the default data converter with the codec chain described by config.
+// The result is meant to be set as client.Options.DataConverter.
+//
+//nolint:ireturn // converter.DataConverter is the type expected by the SDK
+func NewDataConverter(config *model.Config) converter.DataConverter {
+	return converter.NewCodecDataConverter(converter.GetDefaultDataConverter(), NewChain(config)...)
+}
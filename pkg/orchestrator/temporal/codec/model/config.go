@@ -0,0 +1,80 @@
+package model
+
+import "time"
+
+// Config configures the payload codec chain shared by clients, workers and the codec server.
+type Config struct {
+	// Compression enables zlib compression of payloads. A payload is only replaced by its
+	// compressed form when that form is smaller. Default is false.
+	Compression bool
+
+	// ClaimCheck enables offloading of large payloads to a BlobStore. Disabled when nil.
+	ClaimCheck *ClaimCheckConfig
+}
+
+// ClaimCheckConfig configures the claim-check codec, which replaces large payloads
+// with a reference to a copy kept in a BlobStore.
+type ClaimCheckConfig struct {
+	// Store keeps the offloaded payloads.
+	Store BlobStore
+
+	// Threshold is the encoded payload size in bytes above which a payload is offloaded.
+	// The default is 128KiB.
+	Threshold int
+
+	// Timeout bounds every call made to the Store. The default is 10 seconds.
+	Timeout time.Duration
+}
+
+// ServerConfig configures the remote codec HTTP server used by the Temporal UI and CLI.
+type ServerConfig struct {
+	// Address is the TCP address the server listens on. The default is ":8081".
+	Address string
+
+	// AllowedOrigins lists the browser origins allowed to call the server, e.g. the
+	// Temporal UI at "http://localhost:8200". Listed origins may send credentials. A "*" allows
+	// any other origin without credentials.
+	AllowedOrigins []string
+
+	// AuthHeader is the request header compared against AuthToken. The default is "Authorization".
+	AuthHeader string
+
+	// AuthToken, when set, must equal the value of AuthHeader on every request.
+	// When empty, requests are not authenticated.
+	AuthToken string
+}
+
+const (
+	DefaultServerAddress       = ":8081"
+	DefaultAuthHeader          = "Authorization"
+	DefaultClaimCheckThreshold = 128 * 1024
+	DefaultClaimCheckTimeout   = 10 * time.Second
+)
+
+func (c *ClaimCheckConfig) GetThreshold() int {
+	if c.Threshold <= 0 {
+		return DefaultClaimCheckThreshold
+	}
+	return c.Threshold
+}
+
+func (c *ClaimCheckConfig) GetTimeout() time.Duration {
+	if c.Timeout <= 0 {
+		return DefaultClaimCheckTimeout
+	}
+	return c.Timeout
+}
+
+func (c *ServerConfig) GetAddress() string {
+	if c.Address == "" {
+		return DefaultServerAddress
+	}
+	return c.Address
+}
+
+func (c *ServerConfig) GetAuthHeader() string {
+	if c.AuthHeader == "" {
+		return DefaultAuthHeader
+	}
+	return c.AuthHeader
+}
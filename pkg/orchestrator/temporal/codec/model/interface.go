@@ -0,0 +1,26 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrBlobNotFound is returned by a BlobStore when no blob is stored under the requested key.
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore stores payloads that are too large to be kept in workflow history.
+type BlobStore interface {
+	// Put stores data under key, replacing any existing blob with the same key.
+	Put(ctx context.Context, key string, data []byte) error
+
+	// Get returns the data stored under key or ErrBlobNotFound.
+	Get(ctx context.Context, key string) ([]byte, error)
+
+	// Delete removes the blob stored under key. Deleting a missing key is not an error.
+	Delete(ctx context.Context, key string) error
+
+	// DeleteExpired removes every blob last stored before the given time
+	// and returns the number of removed blobs.
+	DeleteExpired(ctx context.Context, before time.Time) (int, error)
+}
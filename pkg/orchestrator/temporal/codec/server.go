@@ -0,0 +1,94 @@
+package codec
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"go.temporal.io/sdk/converter"
+
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/codec/model"
+)
+
+// headers sent by the Temporal UI when it calls a codec endpoint.
+//
+//nolint:gochecknoglobals // read-only list of CORS headers
+var uiHeaders = []string{"Content-Type", "X-Namespace", "Authorization"}
+
+// NewHandler returns an http.Handler serving POST /encode and POST /decode with the given codecs.
+// It answers CORS preflight requests for the configured origins and, when an auth token
+// is configured, rejects requests that do not carry it.
+func NewHandler(config *model.ServerConfig, codecs ...converter.PayloadCodec) http.Handler {
+	return &server{
+		codecHandler: converter.NewPayloadCodecHTTPHandler(codecs...),
+		config:       config,
+	}
+}
+
+type server struct {
+	codecHandler http.Handler
+	config       *model.ServerConfig
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if allowOrigin, credentials := s.allowOrigin(r.Header.Get("Origin")); allowOrigin != "" {
+		w.Header().Set("Access-Control-Allow-Origin", allowOrigin)
+		if credentials {
+			w.Header().Set("Access-Control-Allow-Credentials", "true")
+		}
+		w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", s.allowedHeaders())
+		w.Header().Add("Vary", "Origin")
+	}
+
+	// preflight requests never carry credentials, so they are answered before authentication.
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if !s.authorized(r) {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	s.codecHandler.ServeHTTP(w, r)
+}
+
+// allowOrigin returns the Access-Control-Allow-Origin value for origin, empty when it is not allowed, and
+// whether credentials may be sent. An origin listed explicitly is echoed with credentials, any other origin
+// allowed by "*" gets the wildcard without credentials, so that the auth token is never sent from any site.
+func (s *server) allowOrigin(origin string) (string, bool) {
+	if origin == "" {
+		return "", false
+	}
+	wildcard := false
+	for _, allowed := range s.config.AllowedOrigins {
+		if strings.EqualFold(allowed, origin) {
+			return origin, true
+		}
+		wildcard = wildcard || allowed == "*"
+	}
+	if wildcard {
+		return "*", false
+	}
+	return "", false
+}
+
+func (s *server) allowedHeaders() string {
+	authHeader := s.config.GetAuthHeader()
+	for _, header := range uiHeaders {
+		if strings.EqualFold(header, authHeader) {
+			return strings.Join(uiHeaders, ", ")
+		}
+	}
+	return strings.Join(append(uiHeaders[:len(uiHeaders):len(uiHeaders)], authHeader), ", ")
+}
+
+func (s *server) authorized(r *http.Request) bool {
+	if s.config.AuthToken == "" {
+		return true
+	}
+	got := r.Header.Get(s.config.GetAuthHeader())
+	return subtle.ConstantTimeCompare([]byte(got), []byte(s.config.AuthToken)) == 1
+}
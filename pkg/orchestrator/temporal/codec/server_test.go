@@ -0,0 +1,175 @@
+package codec_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/converter"
+
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/codec"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/codec/model"
+)
+
+const uiOrigin = "http://localhost:8200"
+
+func newTestServer(t *testing.T, config *model.ServerConfig) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(codec.NewHandler(config, codec.NewChain(&model.Config{Compression: true})...))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func post(t *testing.T, url string, body string, headers map[string]string) *http.Response {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	return resp
+}
+
+func TestHandler_EncodeDecode(t *testing.T) {
+	t.Parallel()
+	srv := newTestServer(t, &model.ServerConfig{})
+
+	payload, err := converter.GetDefaultDataConverter().ToPayload(strings.Repeat("compress me ", 100))
+	require.NoError(t, err)
+	plain, err := json.Marshal(map[string]interface{}{"payloads": []interface{}{payload}})
+	require.NoError(t, err)
+
+	encoded := post(t, srv.URL+"/encode", string(plain), nil)
+	require.Equal(t, http.StatusOK, encoded.StatusCode)
+	var encodedBody map[string][]map[string]interface{}
+	require.NoError(t, json.NewDecoder(encoded.Body).Decode(&encodedBody))
+	require.Len(t, encodedBody["payloads"], 1)
+	assert.NotEqual(t, payload.Data, encodedBody["payloads"][0]["data"])
+
+	reEncoded, err := json.Marshal(encodedBody)
+	require.NoError(t, err)
+	decoded := post(t, srv.URL+"/decode", string(reEncoded), nil)
+	require.Equal(t, http.StatusOK, decoded.StatusCode)
+	var decodedBody struct {
+		Payloads []struct {
+			Data []byte `json:"data"`
+		} `json:"payloads"`
+	}
+	require.NoError(t, json.NewDecoder(decoded.Body).Decode(&decodedBody))
+	require.Len(t, decodedBody.Payloads, 1)
+	assert.Equal(t, payload.Data, decodedBody.Payloads[0].Data)
+}
+
+func TestHandler_Auth(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		config  *model.ServerConfig
+		headers map[string]string
+		want    int
+	}{
+		{
+			name:   "no token configured",
+			config: &model.ServerConfig{},
+			want:   http.StatusOK,
+		},
+		{
+			name:   "missing token",
+			config: &model.ServerConfig{AuthToken: "secret"},
+			want:   http.StatusUnauthorized,
+		},
+		{
+			name:    "wrong token",
+			config:  &model.ServerConfig{AuthToken: "secret"},
+			headers: map[string]string{"Authorization": "other"},
+			want:    http.StatusUnauthorized,
+		},
+		{
+			name:    "valid token",
+			config:  &model.ServerConfig{AuthToken: "secret"},
+			headers: map[string]string{"Authorization": "secret"},
+			want:    http.StatusOK,
+		},
+		{
+			name:    "valid token in custom header",
+			config:  &model.ServerConfig{AuthToken: "secret", AuthHeader: "X-Codec-Token"},
+			headers: map[string]string{"X-Codec-Token": "secret"},
+			want:    http.StatusOK,
+		},
+	}
+	for _, tC := range tests {
+		tt := tC
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := newTestServer(t, tt.config)
+			resp := post(t, srv.URL+"/decode", `{"payloads":[]}`, tt.headers)
+			assert.Equal(t, tt.want, resp.StatusCode)
+		})
+	}
+}
+
+func TestHandler_CORS(t *testing.T) {
+	t.Parallel()
+	srv := newTestServer(t, &model.ServerConfig{AllowedOrigins: []string{uiOrigin}, AuthToken: "secret"})
+
+	req, err := http.NewRequest(http.MethodOptions, srv.URL+"/decode", nil)
+	require.NoError(t, err)
+	req.Header.Set("Origin", uiOrigin)
+	req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, uiOrigin, resp.Header.Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "true", resp.Header.Get("Access-Control-Allow-Credentials"))
+	assert.Contains(t, resp.Header.Get("Access-Control-Allow-Headers"), "X-Namespace")
+
+	req.Header.Set("Origin", "http://evil.example")
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Empty(t, resp.Header.Get("Access-Control-Allow-Origin"))
+}
+
+func TestHandler_CORSWildcard(t *testing.T) {
+	t.Parallel()
+	srv := newTestServer(t, &model.ServerConfig{AllowedOrigins: []string{"*", uiOrigin}})
+
+	tests := []struct {
+		name            string
+		origin          string
+		wantOrigin      string
+		wantCredentials string
+	}{
+		{
+			name:            "listed origin",
+			origin:          uiOrigin,
+			wantOrigin:      uiOrigin,
+			wantCredentials: "true",
+		},
+		{
+			name:       "other origin",
+			origin:     "http://evil.example",
+			wantOrigin: "*",
+		},
+	}
+	for _, tC := range tests {
+		tt := tC
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			resp := post(t, srv.URL+"/decode", `{"payloads":[]}`, map[string]string{"Origin": tt.origin})
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, tt.wantOrigin, resp.Header.Get("Access-Control-Allow-Origin"))
+			assert.Equal(t, tt.wantCredentials, resp.Header.Get("Access-Control-Allow-Credentials"))
+		})
+	}
+}
@@ -0,0 +1,222 @@
+// Package determinism provides a go/analysis analyzer that flags non-deterministic code in workflows
+// written against model.Context and model.WorkflowEngine.
+//
+// Every function or function literal with a model.Context or model.WorkflowEngine parameter is treated as
+// workflow code, including the function literals nested in it.
+package determinism
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// ModelPackagePath is the import path of the package declaring model.Context and model.WorkflowEngine.
+const ModelPackagePath = "github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/model"
+
+const doc = `report non-deterministic code in model-based workflows
+
+Functions taking a model.Context or a model.WorkflowEngine run as Temporal workflow code and are replayed,
+so they must not read the clock, start goroutines, use native channels or select, iterate over maps,
+generate random numbers or perform I/O. Use the engine equivalents or move the code to an activity.`
+
+// Analyzer reports non-deterministic code in model-based workflows.
+//
+//nolint:gochecknoglobals // analyzers are declared as package variables by convention
+var Analyzer = &analysis.Analyzer{
+	Name: "workflowcheck",
+	Doc:  doc,
+	Run:  run,
+}
+
+// timeFuncs maps the time functions that read or wait on the wall clock to their engine equivalent.
+//
+//nolint:gochecknoglobals // lookup table
+var timeFuncs = map[string]string{
+	"Now":       "use engine.Now",
+	"Since":     "compute durations from engine.Now",
+	"Until":     "compute durations from engine.Now",
+	"Sleep":     "use engine.Sleep",
+	"After":     "use engine.Sleep or ReceiveWithTimeout",
+	"AfterFunc": "use engine.Sleep",
+	"Tick":      "use engine.Sleep",
+	"NewTimer":  "use engine.Sleep or ReceiveWithTimeout",
+	"NewTicker": "use engine.Sleep",
+}
+
+// forbiddenPackages maps packages whose functions must not be called in workflow code to a suggestion.
+// Subpackages are matched as well.
+//
+//nolint:gochecknoglobals // lookup table
+var forbiddenPackages = map[string]string{
+	"math/rand":   "random numbers differ on replay; generate them in an activity",
+	"crypto/rand": "random numbers differ on replay; generate them in an activity",
+	"os":          "I/O is not replayed; move it to an activity executed with engine.ExecuteActivity",
+	"net":         "network calls are not replayed; move them to an activity executed with engine.ExecuteActivity",
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch fn := n.(type) {
+			case *ast.FuncDecl:
+				if fn.Body != nil && isWorkflowFunc(pass, fn.Type) {
+					checkBody(pass, fn.Body)
+					return false
+				}
+			case *ast.FuncLit:
+				if isWorkflowFunc(pass, fn.Type) {
+					checkBody(pass, fn.Body)
+					return false
+				}
+			}
+			return true
+		})
+	}
+	return nil, nil //nolint:nilnil // the analyzer has no result
+}
+
+// isWorkflowFunc reports whether the function has a model.Context or model.WorkflowEngine parameter.
+func isWorkflowFunc(pass *analysis.Pass, fnType *ast.FuncType) bool {
+	for _, field := range fnType.Params.List {
+		if isModelType(pass.TypesInfo.TypeOf(field.Type)) {
+			return true
+		}
+	}
+	return false
+}
+
+func isModelType(t types.Type) bool {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil || named.Obj().Pkg().Path() != ModelPackagePath {
+		return false
+	}
+	name := named.Obj().Name()
+	return name == "Context" || name == "WorkflowEngine"
+}
+
+func checkBody(pass *analysis.Pass, body *ast.BlockStmt) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.GoStmt:
+			pass.Reportf(node.Pos(), "native goroutines are not deterministic in workflow code; "+
+				"run concurrent work as activities or child workflows through the engine")
+		case *ast.SelectStmt:
+			pass.Reportf(node.Pos(), "select is not deterministic in workflow code; "+
+				"use ReceiveWithTimeout on engine channels")
+		case *ast.SendStmt:
+			reportChannel(pass, node.Pos())
+		case *ast.UnaryExpr:
+			if node.Op == token.ARROW {
+				reportChannel(pass, node.Pos())
+			}
+		case *ast.CallExpr:
+			checkMakeChan(pass, node)
+		case *ast.RangeStmt:
+			checkRange(pass, node)
+		case *ast.SelectorExpr:
+			checkSelector(pass, node)
+		}
+		return true
+	})
+}
+
+func reportChannel(pass *analysis.Pass, pos token.Pos) {
+	pass.Reportf(pos, "native channels are not deterministic in workflow code; "+
+		"use engine.GetSignalChannel to receive signals")
+}
+
+func checkMakeChan(pass *analysis.Pass, call *ast.CallExpr) {
+	ident, ok := call.Fun.(*ast.Ident)
+	if !ok || len(call.Args) == 0 {
+		return
+	}
+	if _, ok := pass.TypesInfo.Uses[ident].(*types.Builtin); !ok || ident.Name != "make" {
+		return
+	}
+	if _, ok := pass.TypesInfo.TypeOf(call.Args[0]).Underlying().(*types.Chan); ok {
+		reportChannel(pass, call.Pos())
+	}
+}
+
+func checkRange(pass *analysis.Pass, stmt *ast.RangeStmt) {
+	switch pass.TypesInfo.TypeOf(stmt.X).Underlying().(type) {
+	case *types.Map:
+		if collectsKeys(pass, stmt) {
+			return
+		}
+		pass.Reportf(stmt.Pos(), "map iteration order is random; "+
+			"iterate over sorted keys in workflow code")
+	case *types.Chan:
+		reportChannel(pass, stmt.Pos())
+	}
+}
+
+// collectsKeys reports whether stmt only appends the keys of a map to a slice, as done to sort them,
+// e.g. for k := range m { keys = append(keys, k) }.
+func collectsKeys(pass *analysis.Pass, stmt *ast.RangeStmt) bool {
+	key, ok := stmt.Key.(*ast.Ident)
+	if !ok || key.Name == "_" || len(stmt.Body.List) != 1 {
+		return false
+	}
+	if value, ok := stmt.Value.(*ast.Ident); stmt.Value != nil && (!ok || value.Name != "_") {
+		return false
+	}
+	assign, ok := stmt.Body.List[0].(*ast.AssignStmt)
+	if !ok || assign.Tok != token.ASSIGN || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return false
+	}
+	call, ok := assign.Rhs[0].(*ast.CallExpr)
+	if !ok || len(call.Args) != 2 || call.Ellipsis.IsValid() {
+		return false
+	}
+	fun, ok := call.Fun.(*ast.Ident)
+	if !ok || fun.Name != "append" {
+		return false
+	}
+	if _, ok := pass.TypesInfo.Uses[fun].(*types.Builtin); !ok {
+		return false
+	}
+	slice, ok := assign.Lhs[0].(*ast.Ident)
+	if !ok {
+		return false
+	}
+	dst, ok := call.Args[0].(*ast.Ident)
+	if !ok || pass.TypesInfo.ObjectOf(dst) != pass.TypesInfo.ObjectOf(slice) {
+		return false
+	}
+	elem, ok := call.Args[1].(*ast.Ident)
+	return ok && pass.TypesInfo.ObjectOf(elem) == pass.TypesInfo.ObjectOf(key)
+}
+
+func checkSelector(pass *analysis.Pass, sel *ast.SelectorExpr) {
+	fn, ok := pass.TypesInfo.Uses[sel.Sel].(*types.Func)
+	if !ok || fn.Pkg() == nil {
+		return
+	}
+	path := fn.Pkg().Path()
+	if path == "time" {
+		if suggestion, ok := timeFuncs[fn.Name()]; ok && isPackageFunc(fn) {
+			pass.Reportf(sel.Pos(), "time.%s is not deterministic in workflow code; %s", fn.Name(), suggestion)
+		}
+		return
+	}
+	for pkg, suggestion := range forbiddenPackages {
+		if path == pkg || strings.HasPrefix(path, pkg+"/") {
+			pass.Reportf(sel.Pos(), "%s.%s is not deterministic in workflow code; %s", path, fn.Name(), suggestion)
+			return
+		}
+	}
+}
+
+// isPackageFunc reports whether fn is a package-level function rather than a method.
+func isPackageFunc(fn *types.Func) bool {
+	sig, ok := fn.Type().(*types.Signature)
+	return ok && sig.Recv() == nil
+}
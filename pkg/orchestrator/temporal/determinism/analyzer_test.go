@@ -0,0 +1,14 @@
+package determinism_test
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/determinism"
+)
+
+func TestAnalyzer(t *testing.T) {
+	t.Parallel()
+	analysistest.Run(t, analysistest.TestData(), determinism.Analyzer, "workflows")
+}
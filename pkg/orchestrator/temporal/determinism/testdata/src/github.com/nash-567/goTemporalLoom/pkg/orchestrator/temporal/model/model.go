@@ -0,0 +1,13 @@
+// Package model is a stub of the real model package for analyzer tests.
+package model
+
+import "time"
+
+type Context interface {
+	Err() error
+}
+
+type WorkflowEngine interface {
+	Now(ctx Context) time.Time
+	Sleep(ctx Context, d time.Duration) error
+}
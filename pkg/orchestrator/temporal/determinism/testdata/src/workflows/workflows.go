@@ -0,0 +1,68 @@
+package workflows
+
+import (
+	"math/rand"
+	"net/http"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/model"
+)
+
+func Workflow(ctx model.Context, engine model.WorkflowEngine, totals map[string]int) (int, error) {
+	start := time.Now()     // want `time.Now is not deterministic in workflow code; use engine.Now`
+	_ = time.Since(start)   // want `time.Since is not deterministic`
+	time.Sleep(time.Second) // want `time.Sleep is not deterministic in workflow code; use engine.Sleep`
+	_ = engine.Now(ctx).Sub(start)
+
+	ch := make(chan int, 1) // want `native channels are not deterministic`
+	go func() {             // want `native goroutines are not deterministic`
+		ch <- 1 // want `native channels are not deterministic`
+	}()
+	select { // want `select is not deterministic`
+	case v := <-ch: // want `native channels are not deterministic`
+		_ = v
+	}
+
+	sum := 0
+	for _, v := range totals { // want `map iteration order is random`
+		sum += v
+	}
+	keys := make([]string, 0, len(totals))
+	for k := range totals {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		sum += totals[k]
+	}
+	values := make([]int, 0, len(totals))
+	for _, v := range totals { // want `map iteration order is random`
+		values = append(values, v)
+	}
+	sum += values[0]
+
+	sum += rand.Intn(10)                  // want `math/rand.Intn is not deterministic in workflow code; random numbers differ on replay`
+	_ = os.Getenv("HOME")                 // want `os.Getenv is not deterministic in workflow code; I/O is not replayed`
+	_, _ = http.Get("http://example.com") // want `net/http.Get is not deterministic in workflow code; network calls are not replayed`
+	return sum, engine.Sleep(ctx, time.Minute)
+}
+
+// Closure returns a workflow function; the literal is checked because it takes a model.Context.
+func Closure() func(ctx model.Context) time.Time {
+	return func(ctx model.Context) time.Time {
+		return time.Now() // want `time.Now is not deterministic`
+	}
+}
+
+// Activity is not workflow code and may do anything.
+func Activity(totals map[string]int) (time.Time, error) {
+	for range totals {
+	}
+	ch := make(chan struct{})
+	go close(ch)
+	<-ch
+	_, err := os.ReadFile("data.txt")
+	return time.Now(), err
+}
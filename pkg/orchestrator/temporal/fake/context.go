@@ -0,0 +1,179 @@
+package fake
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/model"
+)
+
+type (
+	// workflowContext is the fake model.Context passed to the workflow under test.
+	// Every context derived through the engine shares the cancellation of the root context.
+	workflowContext struct {
+		env             *Env
+		parent          *workflowContext
+		activityOptions *model.ActivityOptions
+	}
+
+	// channel is an unbounded fake model.Channel holding JSON encoded values.
+	channel struct {
+		env    *Env
+		name   string
+		buffer []json.RawMessage
+		closed bool
+	}
+
+	// future is a fake model.Future holding a JSON encoded value.
+	future struct {
+		env   *Env
+		ready bool
+		value json.RawMessage
+		err   error
+	}
+
+	// childWorkflowFuture is a fake model.ChildWorkflowFuture for a stubbed child workflow.
+	childWorkflowFuture struct {
+		*future
+		execution  *future
+		workflowID string
+	}
+)
+
+// === Context Methods Start ===
+
+func (c *workflowContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+
+func (c *workflowContext) Done() model.Channel { return c.env.done }
+
+func (c *workflowContext) Err() error {
+	if c.env.canceled {
+		return ErrCanceled
+	}
+	return nil
+}
+
+func (c *workflowContext) Value(interface{}) interface{} { return nil }
+
+// GetParentContext returns the context this one was derived from, or nil for the root context.
+func (c *workflowContext) GetParentContext() interface{} {
+	if c.parent == nil {
+		return nil
+	}
+	return c.parent
+}
+
+// getActivityOptions returns the closest activity options set with WithActivityOptions.
+func (c *workflowContext) getActivityOptions() *model.ActivityOptions {
+	for ctx := c; ctx != nil; ctx = ctx.parent {
+		if ctx.activityOptions != nil {
+			return ctx.activityOptions
+		}
+	}
+	return nil
+}
+
+// === Context Methods End ===
+
+// === Channel Methods Start ===
+
+func newChannel(env *Env, name string) *channel {
+	return &channel{env: env, name: name}
+}
+
+func (c *channel) Name() string { return c.name }
+
+// Send never blocks: fake channels are unbounded.
+func (c *channel) Send(_ model.Context, v interface{}) {
+	c.send(v)
+}
+
+func (c *channel) SendAsync(v interface{}) bool {
+	return c.send(v)
+}
+
+func (c *channel) send(v interface{}) bool {
+	if c.closed {
+		return false
+	}
+	data, err := encode(v)
+	if err != nil {
+		panic(err)
+	}
+	c.buffer = append(c.buffer, data)
+	return true
+}
+
+func (c *channel) Close() { c.closed = true }
+
+func (c *channel) Receive(_ model.Context, valuePtr interface{}) bool {
+	c.env.await(func() bool { return len(c.buffer) > 0 || c.closed })
+	ok, more := c.ReceiveAsyncWithMoreFlag(valuePtr)
+	return ok || more
+}
+
+func (c *channel) ReceiveWithTimeout(ctx model.Context, timeout time.Duration, valuePtr interface{}) (bool, bool) {
+	fired := false
+	t := c.env.addTimer(timeout, func() { fired = true })
+	defer func() { t.canceled = true }()
+
+	c.env.await(func() bool { return len(c.buffer) > 0 || c.closed || fired || ctx.Err() != nil })
+	return c.ReceiveAsyncWithMoreFlag(valuePtr)
+}
+
+func (c *channel) ReceiveAsync(valuePtr interface{}) bool {
+	ok, _ := c.ReceiveAsyncWithMoreFlag(valuePtr)
+	return ok
+}
+
+func (c *channel) ReceiveAsyncWithMoreFlag(valuePtr interface{}) (bool, bool) {
+	if len(c.buffer) == 0 {
+		return false, !c.closed
+	}
+	data := c.buffer[0]
+	c.buffer = c.buffer[1:]
+	if err := decode(data, valuePtr); err != nil {
+		panic(err)
+	}
+	return true, true
+}
+
+func (c *channel) Len() int { return len(c.buffer) }
+
+// === Channel Methods End ===
+
+// === Future Methods Start ===
+
+func newReadyFuture(env *Env, value json.RawMessage, err error) *future {
+	return &future{env: env, ready: true, value: value, err: err}
+}
+
+func (f *future) Get(_ model.Context, valuePtr interface{}) error {
+	f.env.await(func() bool { return f.ready })
+	if f.err != nil {
+		return f.err
+	}
+	return decode(f.value, valuePtr)
+}
+
+func (f *future) IsReady() bool { return f.ready }
+
+//nolint:ireturn // implements model.ChildWorkflowFuture
+func (f *childWorkflowFuture) GetChildWorkflowExecution() model.Future {
+	return f.execution
+}
+
+//nolint:ireturn // implements model.ChildWorkflowFuture
+func (f *childWorkflowFuture) SignalChildWorkflow(_ model.Context, signalName string, data interface{}) model.Future {
+	arg, err := encode(data)
+	if err == nil {
+		f.env.childSignals = append(f.env.childSignals, ChildSignal{
+			WorkflowID: f.workflowID,
+			SignalName: signalName,
+			Arg:        arg,
+		})
+	}
+	return newReadyFuture(f.env, nil, err)
+}
+
+// === Future Methods End ===
@@ -0,0 +1,146 @@
+package fake
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+	"time"
+
+	logModel "github.com/nash-567/goTemporalLoom/pkg/logger/model"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/model"
+)
+
+// fakeRunID is the run ID reported for every stubbed child workflow execution.
+const fakeRunID = "fake-run-id"
+
+// engine implements model.WorkflowEngine on top of an Env.
+type engine struct {
+	env *Env
+}
+
+// GetLogger returns the logger set with Env.SetLogger.
+//
+//nolint:ireturn // implements model.WorkflowEngine
+func (we *engine) GetLogger(model.Context) logModel.Logger {
+	return we.env.logger
+}
+
+// ExecuteActivity runs the activity stub synchronously and returns its result as a ready Future.
+//
+//nolint:ireturn // implements model.WorkflowEngine
+func (we *engine) ExecuteActivity(ctx model.Context, activity interface{}, args ...interface{}) model.Future {
+	if err := ctx.Err(); err != nil {
+		return newReadyFuture(we.env, nil, err)
+	}
+	options := activityOptions(ctx)
+	if options == nil || (options.StartToCloseTimeout == 0 && options.ScheduleToCloseTimeout == 0) {
+		return newReadyFuture(we.env, nil, errMissingTimeout)
+	}
+
+	name := activityName(activity)
+	stub, ok := we.env.activities[name]
+	if !ok {
+		return newReadyFuture(we.env, nil, fmt.Errorf("activity %s: %w", name, ErrNotStubbed))
+	}
+	value, err := call(stub, nil, args)
+	return newReadyFuture(we.env, value, err)
+}
+
+// SetQueryHandler sets a query handler for the workflow.
+func (we *engine) SetQueryHandler(_ model.Context, queryType string, handler interface{}) error {
+	if reflect.ValueOf(handler).Kind() != reflect.Func {
+		return fmt.Errorf("set query handler: %w: %T is not a function", errInvalidFunction, handler)
+	}
+	we.env.queries[queryType] = reflect.ValueOf(handler)
+	return nil
+}
+
+// Sleep blocks until the virtual clock has advanced by d or the workflow is canceled.
+func (we *engine) Sleep(ctx model.Context, d time.Duration) error {
+	fired := false
+	t := we.env.addTimer(d, func() { fired = true })
+	we.env.await(func() bool { return fired || ctx.Err() != nil })
+	if !fired {
+		t.canceled = true
+		return fmt.Errorf("error sleeping workflow: %w", ctx.Err())
+	}
+	return nil
+}
+
+// Now returns the current time of the virtual clock.
+func (we *engine) Now(model.Context) time.Time {
+	return we.env.now
+}
+
+// GetSignalChannel returns the channel fed by Env.SignalWorkflow.
+//
+//nolint:ireturn // implements model.WorkflowEngine
+func (we *engine) GetSignalChannel(_ model.Context, signalName string) model.ReceiveChannel {
+	return we.env.signalChannel(signalName)
+}
+
+// WithActivityOptions returns a new context carrying the options used by ExecuteActivity.
+//
+//nolint:ireturn // implements model.WorkflowEngine
+func (we *engine) WithActivityOptions(ctx model.Context, options model.ActivityOptions) model.Context {
+	parent, _ := ctx.(*workflowContext)
+	return &workflowContext{env: we.env, parent: parent, activityOptions: &options}
+}
+
+// ExecuteChildWorkflow runs the child workflow stub synchronously and returns its result as a ready Future.
+//
+//nolint:ireturn // implements model.WorkflowEngine
+func (we *engine) ExecuteChildWorkflow(
+	ctx model.Context, _ model.ChildWorkflowOptions, childWorkflow model.WorkflowDescriptor, args model.Params,
+) (model.ChildWorkflowFuture, error) {
+	workflowID, err := childWorkflow.GenerateWorkflowID(args)
+	if err != nil {
+		return nil, fmt.Errorf("generate child workflow ID: %w", err)
+	}
+	execution, err := encode(map[string]string{"ID": workflowID, "RunID": fakeRunID})
+	if err != nil {
+		return nil, err
+	}
+
+	f := &childWorkflowFuture{
+		execution:  newReadyFuture(we.env, execution, nil),
+		workflowID: workflowID,
+	}
+	stub, ok := we.env.childWorkflows[childWorkflow.Name()]
+	switch {
+	case ctx.Err() != nil:
+		f.future = newReadyFuture(we.env, nil, ctx.Err())
+	case !ok:
+		f.future = newReadyFuture(we.env, nil, fmt.Errorf("child workflow %s: %w", childWorkflow.Name(), ErrNotStubbed))
+	default:
+		value, err := call(stub, nil, []interface{}{args})
+		f.future = newReadyFuture(we.env, value, err)
+	}
+	return f, nil
+}
+
+func activityOptions(ctx model.Context) *model.ActivityOptions {
+	if c, ok := ctx.(*workflowContext); ok {
+		return c.getActivityOptions()
+	}
+	return nil
+}
+
+// activityName resolves the name an activity would be registered under:
+// the string itself, the descriptor name or the function name.
+func activityName(activity interface{}) string {
+	switch a := activity.(type) {
+	case string:
+		return a
+	case model.ActivityDescriptor:
+		return a.Name()
+	}
+	v := reflect.ValueOf(activity)
+	if v.Kind() != reflect.Func {
+		return fmt.Sprintf("%T", activity)
+	}
+	name := runtime.FuncForPC(v.Pointer()).Name()
+	name = name[strings.LastIndex(name, ".")+1:]
+	return strings.TrimSuffix(name, "-fm")
+}
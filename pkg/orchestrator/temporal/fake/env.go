@@ -0,0 +1,413 @@
+// Package fake provides an in-memory implementation of model.WorkflowEngine for unit tests.
+//
+// Workflows run on a deterministic scheduler: the workflow function runs in its own coroutine, which only
+// makes progress while the test is inside an Env method, and a virtual clock jumps to the next pending
+// timer whenever the workflow is blocked. Activities and child workflows are stubbed by name, and values
+// passed through the engine are JSON encoded the way the default data converter would encode them.
+package fake
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"runtime/debug"
+	"sort"
+	"time"
+
+	logModel "github.com/nash-567/goTemporalLoom/pkg/logger/model"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/model"
+)
+
+var (
+	// ErrDeadlock is returned when the workflow is blocked and no timer can unblock it.
+	ErrDeadlock = errors.New("workflow is blocked with no pending timers or delayed callbacks")
+
+	// ErrCanceled is returned by blocking calls once the workflow has been canceled.
+	ErrCanceled = errors.New("workflow canceled")
+
+	// ErrNotStubbed is returned for activities and child workflows without a stub.
+	ErrNotStubbed = errors.New("not stubbed")
+
+	// ErrPanic wraps a panic raised by the workflow function.
+	ErrPanic = errors.New("workflow panicked")
+
+	errNotStarted      = errors.New("workflow not started")
+	errAlreadyStarted  = errors.New("workflow already started")
+	errUnknownQuery    = errors.New("unknown query type")
+	errInvalidFunction = errors.New("invalid function")
+	errMissingTimeout  = errors.New("activity options must set StartToCloseTimeout or ScheduleToCloseTimeout")
+)
+
+// StartTime is the initial value of the virtual clock.
+//
+//nolint:gochecknoglobals // fixed start of the virtual clock
+var StartTime = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// ChildSignal is a signal sent by the workflow to one of its child workflows.
+type ChildSignal struct {
+	WorkflowID string
+	SignalName string
+	Arg        json.RawMessage
+}
+
+type timer struct {
+	at       time.Time
+	seq      int
+	fn       func()
+	canceled bool
+}
+
+// Env runs a single workflow execution against the fake engine.
+// An Env is not safe for concurrent use; every method must be called from the test goroutine.
+type Env struct {
+	now    time.Time
+	seq    int
+	timers []*timer
+
+	activities     map[string]reflect.Value
+	childWorkflows map[string]reflect.Value
+	queries        map[string]reflect.Value
+	signals        map[string]*channel
+	childSignals   []ChildSignal
+	logger         logModel.Logger
+
+	root     *workflowContext
+	done     *channel
+	canceled bool
+
+	started   bool
+	completed bool
+	exited    bool
+	killed    bool
+	result    json.RawMessage
+	err       error
+	resume    chan struct{}
+	yielded   chan struct{}
+}
+
+// NewEnv returns an Env whose virtual clock starts at StartTime.
+func NewEnv() *Env {
+	e := &Env{
+		now:            StartTime,
+		activities:     make(map[string]reflect.Value),
+		childWorkflows: make(map[string]reflect.Value),
+		queries:        make(map[string]reflect.Value),
+		signals:        make(map[string]*channel),
+		logger:         discardLogger{},
+		resume:         make(chan struct{}),
+		yielded:        make(chan struct{}),
+	}
+	e.done = newChannel(e, "done")
+	e.root = &workflowContext{env: e}
+	return e
+}
+
+// Engine returns the model.WorkflowEngine to be injected into the workflow under test.
+//
+//nolint:ireturn // the fake is only used through the model interface
+func (e *Env) Engine() model.WorkflowEngine {
+	return &engine{env: e}
+}
+
+// SetLogger sets the logger returned by the engine's GetLogger. Logs are discarded by default.
+func (e *Env) SetLogger(log logModel.Logger) {
+	e.logger = log
+}
+
+// Now returns the current time of the virtual clock.
+func (e *Env) Now() time.Time {
+	return e.now
+}
+
+// OnActivity stubs the activity with the given name. fn is called with the arguments passed by the
+// workflow and must return either an error or a value and an error. When the first parameter of fn
+// is a context.Context, a background context is passed, so real activity functions can be used as stubs.
+func (e *Env) OnActivity(name string, fn interface{}) {
+	e.activities[name] = mustFunc(fn)
+}
+
+// OnChildWorkflow stubs the child workflow with the given name. fn is called with the Params passed by
+// the workflow and must return either an error or a value and an error.
+func (e *Env) OnChildWorkflow(name string, fn interface{}) {
+	e.childWorkflows[name] = mustFunc(fn)
+}
+
+// RegisterDelayedCallback runs fn once the virtual clock has advanced by delay.
+// It is typically used to send signals at a given point of the workflow's life.
+func (e *Env) RegisterDelayedCallback(fn func(), delay time.Duration) {
+	e.addTimer(delay, fn)
+}
+
+// StartWorkflow starts workflowFn and runs it until it completes or blocks.
+// workflowFn must take a model.Context followed by args and return either an error or a value and an error.
+func (e *Env) StartWorkflow(workflowFn interface{}, args ...interface{}) {
+	if e.started {
+		panic(errAlreadyStarted)
+	}
+	fn := mustFunc(workflowFn)
+	e.started = true
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				e.err = fmt.Errorf("%w: %v\n%s", ErrPanic, r, debug.Stack())
+			}
+			e.completed = !e.killed
+			e.exited = true
+			e.yielded <- struct{}{}
+		}()
+		<-e.resume
+		e.result, e.err = call(fn, []reflect.Value{reflect.ValueOf(model.Context(e.root))}, args)
+	}()
+	e.runUntilBlocked()
+}
+
+// ExecuteWorkflow starts workflowFn and runs it to completion, advancing the virtual clock whenever
+// the workflow is blocked. If the workflow blocks with nothing left that could unblock it, it is
+// stopped and GetWorkflowError returns ErrDeadlock.
+func (e *Env) ExecuteWorkflow(workflowFn interface{}, args ...interface{}) {
+	e.StartWorkflow(workflowFn, args...)
+	for !e.exited {
+		if !e.fireNextTimer(nil) {
+			e.kill()
+			e.err = ErrDeadlock
+		}
+	}
+}
+
+// Advance moves the virtual clock forward by d, firing due timers and delayed callbacks in order
+// and letting the workflow run after each of them.
+func (e *Env) Advance(d time.Duration) {
+	target := e.now.Add(d)
+	for e.fireNextTimer(&target) {
+	}
+	e.now = target
+}
+
+// SignalWorkflow delivers a signal to the workflow and lets it run until it blocks again.
+func (e *Env) SignalWorkflow(name string, arg interface{}) {
+	e.signalChannel(name).send(arg)
+	e.runUntilBlocked()
+}
+
+// CancelWorkflow cancels the workflow context and lets the workflow run until it blocks again.
+func (e *Env) CancelWorkflow() {
+	if e.canceled {
+		return
+	}
+	e.canceled = true
+	e.done.Close()
+	e.runUntilBlocked()
+}
+
+// QueryWorkflow invokes the query handler registered for queryType and decodes its result into valuePtr.
+func (e *Env) QueryWorkflow(queryType string, valuePtr interface{}, args ...interface{}) error {
+	handler, ok := e.queries[queryType]
+	if !ok {
+		return fmt.Errorf("%w: %s", errUnknownQuery, queryType)
+	}
+	result, err := call(handler, nil, args)
+	if err != nil {
+		return err
+	}
+	return decode(result, valuePtr)
+}
+
+// IsWorkflowCompleted reports whether the workflow function has returned.
+func (e *Env) IsWorkflowCompleted() bool {
+	return e.completed
+}
+
+// GetWorkflowError returns the error returned by the workflow, ErrDeadlock or a wrapped ErrPanic.
+func (e *Env) GetWorkflowError() error {
+	return e.err
+}
+
+// GetWorkflowResult decodes the value returned by the workflow into valuePtr.
+func (e *Env) GetWorkflowResult(valuePtr interface{}) error {
+	if !e.completed {
+		return errNotStarted
+	}
+	if e.err != nil {
+		return e.err
+	}
+	return decode(e.result, valuePtr)
+}
+
+// ChildSignals returns the signals the workflow sent to its child workflows, in order.
+func (e *Env) ChildSignals() []ChildSignal {
+	return e.childSignals
+}
+
+// Close stops a workflow that has not completed. It is only needed for workflows driven
+// with StartWorkflow that are left blocked at the end of a test.
+func (e *Env) Close() {
+	e.kill()
+}
+
+// === Scheduler Start ===
+
+// runUntilBlocked hands control to the workflow coroutine and waits until it blocks or exits.
+func (e *Env) runUntilBlocked() {
+	if !e.started || e.exited {
+		return
+	}
+	e.resume <- struct{}{}
+	<-e.yielded
+}
+
+// await blocks the workflow coroutine until cond holds. It must only be called by workflow code.
+func (e *Env) await(cond func() bool) {
+	for !cond() {
+		e.yielded <- struct{}{}
+		<-e.resume
+		if e.killed {
+			runtime.Goexit()
+		}
+	}
+}
+
+func (e *Env) kill() {
+	if !e.started || e.exited {
+		return
+	}
+	e.killed = true
+	e.runUntilBlocked()
+}
+
+func (e *Env) addTimer(d time.Duration, fn func()) *timer {
+	e.seq++
+	t := &timer{at: e.now.Add(d), seq: e.seq, fn: fn}
+	e.timers = append(e.timers, t)
+	sort.SliceStable(e.timers, func(i, j int) bool {
+		if e.timers[i].at.Equal(e.timers[j].at) {
+			return e.timers[i].seq < e.timers[j].seq
+		}
+		return e.timers[i].at.Before(e.timers[j].at)
+	})
+	return t
+}
+
+// fireNextTimer fires the earliest pending timer due no later than limit, if any.
+// Canceled timers are dropped without moving the clock.
+func (e *Env) fireNextTimer(limit *time.Time) bool {
+	for len(e.timers) > 0 {
+		t := e.timers[0]
+		if t.canceled {
+			e.timers = e.timers[1:]
+			continue
+		}
+		if limit != nil && t.at.After(*limit) {
+			return false
+		}
+		e.timers = e.timers[1:]
+		if t.at.After(e.now) {
+			e.now = t.at
+		}
+		t.fn()
+		e.runUntilBlocked()
+		return true
+	}
+	return false
+}
+
+// === Scheduler End ===
+
+func (e *Env) signalChannel(name string) *channel {
+	ch, ok := e.signals[name]
+	if !ok {
+		ch = newChannel(e, name)
+		e.signals[name] = ch
+	}
+	return ch
+}
+
+// mustFunc panics when fn is not a function, which is a programming error in the test.
+func mustFunc(fn interface{}) reflect.Value {
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func {
+		panic(fmt.Errorf("%w: %T is not a function", errInvalidFunction, fn))
+	}
+	return v
+}
+
+//nolint:gochecknoglobals // reflected types used when calling stubs
+var (
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+)
+
+// call invokes fn with the leading values followed by args converted to fn's parameter types,
+// and returns the JSON encoded result.
+func call(fn reflect.Value, leading []reflect.Value, args []interface{}) (json.RawMessage, error) {
+	fnType := fn.Type()
+	in := append([]reflect.Value(nil), leading...)
+	if len(in) == 0 && fnType.NumIn() > 0 && fnType.In(0) == contextType {
+		in = append(in, reflect.ValueOf(context.Background()))
+	}
+	if fnType.NumIn() != len(in)+len(args) {
+		return nil, fmt.Errorf("%w: %s takes %d arguments, got %d", errInvalidFunction, fnType, fnType.NumIn(), len(in)+len(args))
+	}
+	for _, arg := range args {
+		v, err := convert(arg, fnType.In(len(in)))
+		if err != nil {
+			return nil, err
+		}
+		in = append(in, v)
+	}
+
+	out := fn.Call(in)
+	switch {
+	case len(out) == 1 && fnType.Out(0) == errorType:
+		err, _ := out[0].Interface().(error)
+		return nil, err
+	case len(out) == 2 && fnType.Out(1) == errorType:
+		if err, _ := out[1].Interface().(error); err != nil {
+			return nil, err
+		}
+		return encode(out[0].Interface())
+	default:
+		return nil, fmt.Errorf("%w: %s must return error or (value, error)", errInvalidFunction, fnType)
+	}
+}
+
+// convert turns arg into a value of type t, going through JSON when the types differ.
+func convert(arg interface{}, t reflect.Type) (reflect.Value, error) {
+	if arg == nil {
+		return reflect.Zero(t), nil
+	}
+	v := reflect.ValueOf(arg)
+	if v.Type().AssignableTo(t) {
+		return v, nil
+	}
+	data, err := encode(arg)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	ptr := reflect.New(t)
+	if err := json.Unmarshal(data, ptr.Interface()); err != nil {
+		return reflect.Value{}, fmt.Errorf("decode %s: %w", t, err)
+	}
+	return ptr.Elem(), nil
+}
+
+func encode(v interface{}) (json.RawMessage, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("encode %T: %w", v, err)
+	}
+	return data, nil
+}
+
+func decode(data json.RawMessage, valuePtr interface{}) error {
+	if valuePtr == nil || len(data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, valuePtr); err != nil {
+		return fmt.Errorf("decode %T: %w", valuePtr, err)
+	}
+	return nil
+}
@@ -0,0 +1,226 @@
+package fake_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/fake"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/model"
+)
+
+var errPayment = errors.New("payment declined")
+
+type order struct {
+	ID     string `json:"id"`
+	Amount int    `json:"amount"`
+}
+
+func (o *order) Marshal() ([]byte, error) { return json.Marshal(o) }
+func (o *order) String() string           { return o.ID }
+
+type shipmentWorkflow struct{}
+
+func (shipmentWorkflow) Name() string        { return "ShipmentWorkflow" }
+func (shipmentWorkflow) Description() string { return "ships an order" }
+func (shipmentWorkflow) GenerateWorkflowID(in model.Params) (string, error) {
+	return "shipment-" + in.String(), nil
+}
+
+func chargeActivity(context.Context, order) (string, error) {
+	return "", errors.New("real activity must not run")
+}
+
+// orderWorkflow charges an order, waits for its approval and ships it.
+func orderWorkflow(engine model.WorkflowEngine) func(ctx model.Context, in order) (string, error) {
+	return func(ctx model.Context, in order) (string, error) {
+		status := "received"
+		if err := engine.SetQueryHandler(ctx, "status", func() (string, error) { return status, nil }); err != nil {
+			return "", err
+		}
+		ctx = engine.WithActivityOptions(ctx, model.ActivityOptions{StartToCloseTimeout: time.Minute})
+
+		var receipt string
+		if err := engine.ExecuteActivity(ctx, chargeActivity, in).Get(ctx, &receipt); err != nil {
+			return "", fmt.Errorf("charge: %w", err)
+		}
+		status = "charged"
+
+		var approver string
+		ok, _ := engine.GetSignalChannel(ctx, "approve").ReceiveWithTimeout(ctx, time.Hour, &approver)
+		if !ok && ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		if !ok {
+			return "", errors.New("approval timed out")
+		}
+		status = "approved"
+
+		if err := engine.Sleep(ctx, 10*time.Minute); err != nil {
+			return "", err
+		}
+
+		child, err := engine.ExecuteChildWorkflow(ctx, model.ChildWorkflowOptions{}, shipmentWorkflow{}, &in)
+		if err != nil {
+			return "", err
+		}
+		child.SignalChildWorkflow(ctx, "priority", "express")
+		var tracking string
+		if err := child.Get(ctx, &tracking); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s/%s/%s", receipt, approver, tracking), nil
+	}
+}
+
+func TestEnv_ExecuteWorkflow(t *testing.T) {
+	t.Parallel()
+	env := fake.NewEnv()
+	env.OnActivity("chargeActivity", func(in order) (string, error) {
+		return fmt.Sprintf("receipt-%d", in.Amount), nil
+	})
+	env.OnChildWorkflow("ShipmentWorkflow", func(in *order) (string, error) {
+		return "track-" + in.ID, nil
+	})
+	env.RegisterDelayedCallback(func() { env.SignalWorkflow("approve", "alice") }, 30*time.Minute)
+
+	env.ExecuteWorkflow(orderWorkflow(env.Engine()), order{ID: "o-1", Amount: 42})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var result string
+	require.NoError(t, env.GetWorkflowResult(&result))
+	assert.Equal(t, "receipt-42/alice/track-o-1", result)
+	assert.Equal(t, fake.StartTime.Add(40*time.Minute), env.Now())
+
+	signals := env.ChildSignals()
+	require.Len(t, signals, 1)
+	assert.Equal(t, "shipment-o-1", signals[0].WorkflowID)
+	assert.Equal(t, "priority", signals[0].SignalName)
+	assert.JSONEq(t, `"express"`, string(signals[0].Arg))
+}
+
+func TestEnv_StepByStep(t *testing.T) {
+	t.Parallel()
+	env := fake.NewEnv()
+	defer env.Close()
+	env.OnActivity("chargeActivity", func(order) (string, error) { return "receipt", nil })
+
+	env.StartWorkflow(orderWorkflow(env.Engine()), order{ID: "o-2"})
+
+	var status string
+	require.NoError(t, env.QueryWorkflow("status", &status))
+	assert.Equal(t, "charged", status)
+
+	env.Advance(59 * time.Minute)
+	assert.False(t, env.IsWorkflowCompleted())
+
+	env.SignalWorkflow("approve", "bob")
+	require.NoError(t, env.QueryWorkflow("status", &status))
+	assert.Equal(t, "approved", status)
+
+	env.Advance(9 * time.Minute)
+	assert.False(t, env.IsWorkflowCompleted())
+}
+
+func TestEnv_Errors(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		desc    string
+		setup   func(env *fake.Env)
+		wantErr error
+	}{
+		{
+			desc: "activity failure",
+			setup: func(env *fake.Env) {
+				env.OnActivity("chargeActivity", func(order) (string, error) { return "", errPayment })
+			},
+			wantErr: errPayment,
+		},
+		{
+			desc:    "activity not stubbed",
+			setup:   func(*fake.Env) {},
+			wantErr: fake.ErrNotStubbed,
+		},
+		{
+			desc: "canceled while waiting for approval",
+			setup: func(env *fake.Env) {
+				env.OnActivity("chargeActivity", func(order) (string, error) { return "receipt", nil })
+				env.RegisterDelayedCallback(func() { env.CancelWorkflow() }, time.Minute)
+			},
+			wantErr: fake.ErrCanceled,
+		},
+	}
+	for _, tC := range tests {
+		tt := tC
+		t.Run(tt.desc, func(t *testing.T) {
+			t.Parallel()
+			env := fake.NewEnv()
+			tt.setup(env)
+
+			env.ExecuteWorkflow(orderWorkflow(env.Engine()), order{ID: "o-3"})
+
+			require.True(t, env.IsWorkflowCompleted())
+			assert.ErrorIs(t, env.GetWorkflowError(), tt.wantErr)
+		})
+	}
+}
+
+func TestEnv_Deadlock(t *testing.T) {
+	t.Parallel()
+	env := fake.NewEnv()
+	engine := env.Engine()
+
+	env.ExecuteWorkflow(func(ctx model.Context) error {
+		var v string
+		engine.GetSignalChannel(ctx, "never").Receive(ctx, &v)
+		return nil
+	})
+
+	assert.False(t, env.IsWorkflowCompleted())
+	assert.ErrorIs(t, env.GetWorkflowError(), fake.ErrDeadlock)
+}
+
+func TestEnv_ToTemporalContext(t *testing.T) {
+	t.Parallel()
+	env := fake.NewEnv()
+
+	// a workflow reaching for the SDK directly is a programming error reported by a descriptive panic.
+	env.ExecuteWorkflow(func(ctx model.Context) error {
+		model.ToTemporalContext(ctx)
+		return nil
+	})
+
+	err := env.GetWorkflowError()
+	require.ErrorIs(t, err, fake.ErrPanic)
+	assert.ErrorContains(t, err, model.ErrNotTemporalContext.Error())
+}
+
+func TestEnv_Panic(t *testing.T) {
+	t.Parallel()
+	env := fake.NewEnv()
+
+	env.ExecuteWorkflow(func(model.Context) error { panic("boom") })
+
+	assert.ErrorIs(t, env.GetWorkflowError(), fake.ErrPanic)
+}
+
+func TestEnv_MissingActivityTimeout(t *testing.T) {
+	t.Parallel()
+	env := fake.NewEnv()
+	env.OnActivity("chargeActivity", chargeActivity)
+	engine := env.Engine()
+
+	env.ExecuteWorkflow(func(ctx model.Context) error {
+		return engine.ExecuteActivity(ctx, "chargeActivity", order{}).Get(ctx, nil)
+	})
+
+	require.Error(t, env.GetWorkflowError())
+	assert.Contains(t, env.GetWorkflowError().Error(), "StartToCloseTimeout")
+}
@@ -0,0 +1,38 @@
+package fake
+
+import (
+	"context"
+	"io"
+	"log/slog"
+
+	logModel "github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+// discardLogger is the logger returned by the engine until Env.SetLogger is called.
+type discardLogger struct{}
+
+func (discardLogger) Debug(string) {}
+func (discardLogger) Info(string)  {}
+func (discardLogger) Warn(string)  {}
+func (discardLogger) Error(string) {}
+func (discardLogger) Fatal(string) {}
+
+func (discardLogger) DebugCtx(context.Context, string) {}
+func (discardLogger) InfoCtx(context.Context, string)  {}
+func (discardLogger) WarnCtx(context.Context, string)  {}
+func (discardLogger) ErrorCtx(context.Context, string) {}
+func (discardLogger) FatalCtx(context.Context, string) {}
+
+//nolint:ireturn // implements model.Logger interface
+func (l discardLogger) WithField(string, interface{}) logModel.Logger { return l }
+
+//nolint:ireturn // implements model.Logger interface
+func (l discardLogger) WithFields(logModel.Fields) logModel.Logger { return l }
+
+//nolint:ireturn // implements model.Logger interface
+func (l discardLogger) WithError(error) logModel.Logger { return l }
+
+//nolint:ireturn // implements model.Logger interface
+func (discardLogger) ToKeyValLogger() logModel.KeyValLogger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
@@ -0,0 +1,296 @@
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+	sdkInterceptor "go.temporal.io/sdk/interceptor"
+	"go.temporal.io/sdk/workflow"
+
+	logModel "github.com/nash-567/goTemporalLoom/pkg/logger/model"
+)
+
+// loggingInterceptor logs the lifecycle of workflows, activities and client calls through a model.Logger.
+type loggingInterceptor struct {
+	sdkInterceptor.InterceptorBase
+	log logModel.Logger
+}
+
+// NewLoggingInterceptor returns an interceptor that logs workflow starts and completions,
+// activity attempts, signals, queries and updates through log.
+// It implements both the worker and the client interceptor, so the same value can be set
+// in worker.Options.Interceptors and client.Options.Interceptors.
+// Workflow logs are suppressed while the workflow is replaying.
+//
+//nolint:ireturn // sdkInterceptor.Interceptor is the type expected by the SDK
+func NewLoggingInterceptor(log logModel.Logger) sdkInterceptor.Interceptor {
+	return &loggingInterceptor{log: log}
+}
+
+//nolint:ireturn // implements sdkInterceptor.WorkerInterceptor
+func (i *loggingInterceptor) InterceptActivity(
+	ctx context.Context,
+	next sdkInterceptor.ActivityInboundInterceptor,
+) sdkInterceptor.ActivityInboundInterceptor {
+	a := &activityLoggingInterceptor{log: i.log}
+	a.Next = next
+	return a
+}
+
+//nolint:ireturn // implements sdkInterceptor.WorkerInterceptor
+func (i *loggingInterceptor) InterceptWorkflow(
+	ctx workflow.Context,
+	next sdkInterceptor.WorkflowInboundInterceptor,
+) sdkInterceptor.WorkflowInboundInterceptor {
+	w := &workflowLoggingInterceptor{log: i.log}
+	w.Next = next
+	return w
+}
+
+//nolint:ireturn // implements sdkInterceptor.ClientInterceptor
+func (i *loggingInterceptor) InterceptClient(
+	next sdkInterceptor.ClientOutboundInterceptor,
+) sdkInterceptor.ClientOutboundInterceptor {
+	c := &clientLoggingInterceptor{log: i.log}
+	c.Next = next
+	return c
+}
+
+// === Activity Interceptor Start ===
+
+type activityLoggingInterceptor struct {
+	sdkInterceptor.ActivityInboundInterceptorBase
+	log logModel.Logger
+}
+
+func (a *activityLoggingInterceptor) ExecuteActivity(
+	ctx context.Context,
+	in *sdkInterceptor.ExecuteActivityInput,
+) (interface{}, error) {
+	info := activity.GetInfo(ctx)
+	log := a.log.WithFields(logModel.Fields{
+		"activity_type": info.ActivityType.Name,
+		"activity_id":   info.ActivityID,
+		"attempt":       info.Attempt,
+		"workflow_id":   info.WorkflowExecution.ID,
+		"run_id":        info.WorkflowExecution.RunID,
+	})
+
+	start := time.Now()
+	result, err := a.Next.ExecuteActivity(ctx, in)
+	log = log.WithField("duration", time.Since(start).String())
+	if err != nil {
+		log.WithError(err).Warn("activity attempt failed")
+		return result, err //nolint:wrapcheck // errors are passed through unchanged
+	}
+	log.Info("activity attempt completed")
+	return result, nil
+}
+
+// === Activity Interceptor End ===
+
+// === Workflow Interceptor Start ===
+
+type workflowLoggingInterceptor struct {
+	sdkInterceptor.WorkflowInboundInterceptorBase
+	log logModel.Logger
+}
+
+// logger returns the logger tagged with the workflow identity, or nil while replaying.
+func (w *workflowLoggingInterceptor) logger(ctx workflow.Context) logModel.Logger {
+	if workflow.IsReplaying(ctx) {
+		return nil
+	}
+	info := workflow.GetInfo(ctx)
+	return w.log.WithFields(logModel.Fields{
+		"workflow_type": info.WorkflowType.Name,
+		"workflow_id":   info.WorkflowExecution.ID,
+		"run_id":        info.WorkflowExecution.RunID,
+		"attempt":       info.Attempt,
+	})
+}
+
+func (w *workflowLoggingInterceptor) ExecuteWorkflow(
+	ctx workflow.Context,
+	in *sdkInterceptor.ExecuteWorkflowInput,
+) (interface{}, error) {
+	if log := w.logger(ctx); log != nil {
+		log.WithField("task_queue", workflow.GetInfo(ctx).TaskQueueName).Info("workflow started")
+	}
+
+	start := workflow.Now(ctx)
+	result, err := w.Next.ExecuteWorkflow(ctx, in)
+
+	if log := w.logger(ctx); log != nil {
+		log = log.WithField("duration", workflow.Now(ctx).Sub(start).String())
+		if err != nil {
+			log.WithError(err).Error("workflow failed")
+		} else {
+			log.Info("workflow completed")
+		}
+	}
+	return result, err //nolint:wrapcheck // errors are passed through unchanged
+}
+
+func (w *workflowLoggingInterceptor) HandleSignal(ctx workflow.Context, in *sdkInterceptor.HandleSignalInput) error {
+	if log := w.logger(ctx); log != nil {
+		log.WithField("signal_name", in.SignalName).Info("signal received")
+	}
+	return w.Next.HandleSignal(ctx, in) //nolint:wrapcheck // errors are passed through unchanged
+}
+
+func (w *workflowLoggingInterceptor) HandleQuery(
+	ctx workflow.Context,
+	in *sdkInterceptor.HandleQueryInput,
+) (interface{}, error) {
+	result, err := w.Next.HandleQuery(ctx, in)
+	if log := w.logger(ctx); log != nil {
+		log = log.WithField("query_type", in.QueryType)
+		if err != nil {
+			log.WithError(err).Warn("query failed")
+		} else {
+			log.Debug("query handled")
+		}
+	}
+	return result, err //nolint:wrapcheck // errors are passed through unchanged
+}
+
+func (w *workflowLoggingInterceptor) ValidateUpdate(ctx workflow.Context, in *sdkInterceptor.UpdateInput) error {
+	err := w.Next.ValidateUpdate(ctx, in)
+	if log := w.logger(ctx); log != nil && err != nil {
+		log.WithField("update_name", in.Name).WithError(err).Warn("update rejected")
+	}
+	return err //nolint:wrapcheck // errors are passed through unchanged
+}
+
+func (w *workflowLoggingInterceptor) ExecuteUpdate(
+	ctx workflow.Context,
+	in *sdkInterceptor.UpdateInput,
+) (interface{}, error) {
+	if log := w.logger(ctx); log != nil {
+		log.WithField("update_name", in.Name).Info("update received")
+	}
+
+	result, err := w.Next.ExecuteUpdate(ctx, in)
+
+	if log := w.logger(ctx); log != nil {
+		log = log.WithField("update_name", in.Name)
+		if err != nil {
+			log.WithError(err).Warn("update failed")
+		} else {
+			log.Info("update completed")
+		}
+	}
+	return result, err //nolint:wrapcheck // errors are passed through unchanged
+}
+
+// === Workflow Interceptor End ===
+
+// === Client Interceptor Start ===
+
+type clientLoggingInterceptor struct {
+	sdkInterceptor.ClientOutboundInterceptorBase
+	log logModel.Logger
+}
+
+//nolint:ireturn // implements sdkInterceptor.ClientOutboundInterceptor
+func (c *clientLoggingInterceptor) ExecuteWorkflow(
+	ctx context.Context,
+	in *sdkInterceptor.ClientExecuteWorkflowInput,
+) (client.WorkflowRun, error) {
+	log := c.log.WithFields(logModel.Fields{
+		"workflow_type": in.WorkflowType,
+		"workflow_id":   in.Options.ID,
+		"task_queue":    in.Options.TaskQueue,
+	})
+
+	run, err := c.Next.ExecuteWorkflow(ctx, in)
+	if err != nil {
+		log.WithError(err).Error("failed to start workflow")
+		return run, err //nolint:wrapcheck // errors are passed through unchanged
+	}
+	log.WithField("run_id", run.GetRunID()).Info("workflow start requested")
+	return run, nil
+}
+
+func (c *clientLoggingInterceptor) SignalWorkflow(ctx context.Context, in *sdkInterceptor.ClientSignalWorkflowInput) error {
+	log := c.log.WithFields(logModel.Fields{
+		"workflow_id": in.WorkflowID,
+		"run_id":      in.RunID,
+		"signal_name": in.SignalName,
+	})
+
+	if err := c.Next.SignalWorkflow(ctx, in); err != nil {
+		log.WithError(err).Error("failed to signal workflow")
+		return err //nolint:wrapcheck // errors are passed through unchanged
+	}
+	log.Info("workflow signaled")
+	return nil
+}
+
+//nolint:ireturn // implements sdkInterceptor.ClientOutboundInterceptor
+func (c *clientLoggingInterceptor) SignalWithStartWorkflow(
+	ctx context.Context,
+	in *sdkInterceptor.ClientSignalWithStartWorkflowInput,
+) (client.WorkflowRun, error) {
+	log := c.log.WithFields(logModel.Fields{
+		"workflow_type": in.WorkflowType,
+		"workflow_id":   in.Options.ID,
+		"task_queue":    in.Options.TaskQueue,
+		"signal_name":   in.SignalName,
+	})
+
+	run, err := c.Next.SignalWithStartWorkflow(ctx, in)
+	if err != nil {
+		log.WithError(err).Error("failed to signal with start workflow")
+		return run, err //nolint:wrapcheck // errors are passed through unchanged
+	}
+	log.WithField("run_id", run.GetRunID()).Info("workflow signaled with start")
+	return run, nil
+}
+
+//nolint:ireturn // implements sdkInterceptor.ClientOutboundInterceptor
+func (c *clientLoggingInterceptor) QueryWorkflow(
+	ctx context.Context,
+	in *sdkInterceptor.ClientQueryWorkflowInput,
+) (converter.EncodedValue, error) {
+	log := c.log.WithFields(logModel.Fields{
+		"workflow_id": in.WorkflowID,
+		"run_id":      in.RunID,
+		"query_type":  in.QueryType,
+	})
+
+	value, err := c.Next.QueryWorkflow(ctx, in)
+	if err != nil {
+		log.WithError(err).Warn("failed to query workflow")
+		return value, err //nolint:wrapcheck // errors are passed through unchanged
+	}
+	log.Debug("workflow queried")
+	return value, nil
+}
+
+//nolint:ireturn // implements sdkInterceptor.ClientOutboundInterceptor
+func (c *clientLoggingInterceptor) UpdateWorkflow(
+	ctx context.Context,
+	in *sdkInterceptor.ClientUpdateWorkflowInput,
+) (client.WorkflowUpdateHandle, error) {
+	log := c.log.WithFields(logModel.Fields{
+		"workflow_id": in.WorkflowID,
+		"run_id":      in.RunID,
+		"update_name": in.UpdateName,
+		"update_id":   in.UpdateID,
+	})
+
+	handle, err := c.Next.UpdateWorkflow(ctx, in)
+	if err != nil {
+		log.WithError(err).Warn("failed to update workflow")
+		return handle, err //nolint:wrapcheck // errors are passed through unchanged
+	}
+	log.Info("workflow update requested")
+	return handle, nil
+}
+
+// === Client Interceptor End ===
@@ -0,0 +1,76 @@
+package interceptor_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/activity"
+	sdkInterceptor "go.temporal.io/sdk/interceptor"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger/logtest"
+	logModel "github.com/nash-567/goTemporalLoom/pkg/logger/model"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/interceptor"
+)
+
+var errFirstAttempt = errors.New("first attempt fails")
+
+func flakyActivity(ctx context.Context) (string, error) {
+	if activity.GetInfo(ctx).Attempt == 1 {
+		return "", errFirstAttempt
+	}
+	return "done", nil
+}
+
+func greetingWorkflow(ctx workflow.Context) (string, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy:         &temporal.RetryPolicy{MaximumAttempts: 2},
+	})
+	var name string
+	workflow.GetSignalChannel(ctx, "name").Receive(ctx, &name)
+
+	var result string
+	if err := workflow.ExecuteActivity(ctx, flakyActivity).Get(ctx, &result); err != nil {
+		return "", err
+	}
+	return result + " " + name, nil
+}
+
+func TestLoggingInterceptor_Worker(t *testing.T) {
+	t.Parallel()
+	log := logtest.NewTB(t)
+
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.SetWorkerOptions(worker.Options{
+		Interceptors: []sdkInterceptor.WorkerInterceptor{interceptor.NewLoggingInterceptor(log)},
+	})
+	env.RegisterWorkflow(greetingWorkflow)
+	env.RegisterActivity(flakyActivity)
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow("name", "loom")
+	}, time.Second)
+
+	env.ExecuteWorkflow(greetingWorkflow)
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	log.AssertLogged(t, logModel.InfoLevel, "workflow started", logModel.Fields{"workflow_type": "greetingWorkflow"})
+	log.AssertLogged(t, logModel.InfoLevel, "signal received", logModel.Fields{"signal_name": "name"})
+	log.AssertLogged(t, logModel.WarnLevel, "activity attempt failed", logModel.Fields{
+		"activity_type": "flakyActivity",
+		"attempt":       int32(1),
+		"error":         "first attempt fails",
+	})
+	log.AssertLogged(t, logModel.InfoLevel, "activity attempt completed", logModel.Fields{"attempt": int32(2)})
+	log.AssertLogged(t, logModel.InfoLevel, "workflow completed", logModel.Fields{"workflow_type": "greetingWorkflow"})
+	assert.Len(t, log.Find("workflow started"), 1)
+}
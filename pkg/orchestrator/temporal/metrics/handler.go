@@ -0,0 +1,171 @@
+package metrics
+
+import (
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.temporal.io/sdk/client"
+)
+
+// sdkLabels are the label names of every SDK metric: the tags set by the SDK, sorted. Prometheus requires
+// every series of a metric to have the same label names, so a metric always has all of them, tags missing
+// from a use of the metric get an empty value and tags not listed here are dropped.
+//
+//nolint:gochecknoglobals // read-only list of label names
+var sdkLabels = []string{
+	"activity_type",
+	"cause",
+	"client_name",
+	"namespace",
+	"nexus_operation",
+	"nexus_service",
+	"operation",
+	"poller_type",
+	"task_queue",
+	"worker_type",
+	"workflow_type",
+}
+
+// tags of SDK metrics whose values are bounded by the label limiter.
+//
+//nolint:gochecknoglobals // read-only set of tag names
+var limitedTags = map[string]struct{}{
+	"workflow_type": {},
+	"activity_type": {},
+}
+
+type (
+	// handler implements client.MetricsHandler on top of the Prometheus registry.
+	handler struct {
+		metrics *Metrics
+		tags    map[string]string
+	}
+
+	// counter implements client.MetricsCounter.
+	counter struct{ prometheus.Counter }
+
+	// gauge implements client.MetricsGauge.
+	gauge struct{ prometheus.Gauge }
+
+	// timer implements client.MetricsTimer.
+	timer struct{ prometheus.Observer }
+)
+
+func (c counter) Inc(i int64) { c.Add(float64(i)) }
+
+func (g gauge) Update(f float64) { g.Set(f) }
+
+func (t timer) Record(d time.Duration) { t.Observe(d.Seconds()) }
+
+// Handler returns the client.MetricsHandler to be set as client.Options.MetricsHandler.
+//
+//nolint:ireturn // client.MetricsHandler is the type expected by the SDK
+func (m *Metrics) Handler() client.MetricsHandler {
+	return &handler{metrics: m, tags: map[string]string{}}
+}
+
+//nolint:ireturn // implements client.MetricsHandler
+func (h *handler) WithTags(tags map[string]string) client.MetricsHandler {
+	merged := make(map[string]string, len(h.tags)+len(tags))
+	for k, v := range h.tags {
+		merged[k] = v
+	}
+	for k, v := range tags {
+		merged[sanitizeName(k)] = v
+	}
+	return &handler{metrics: h.metrics, tags: merged}
+}
+
+//nolint:ireturn // implements client.MetricsHandler
+func (h *handler) Counter(name string) client.MetricsCounter {
+	name = sanitizeName(name)
+	return counter{h.metrics.counterVec(name).With(h.labels())}
+}
+
+//nolint:ireturn // implements client.MetricsHandler
+func (h *handler) Gauge(name string) client.MetricsGauge {
+	name = sanitizeName(name)
+	return gauge{h.metrics.gaugeVec(name).With(h.labels())}
+}
+
+//nolint:ireturn // implements client.MetricsHandler
+func (h *handler) Timer(name string) client.MetricsTimer {
+	name = sanitizeName(name)
+	return timer{h.metrics.histogramVec(name).With(h.labels())}
+}
+
+// labels returns the values of sdkLabels.
+func (h *handler) labels() prometheus.Labels {
+	labels := make(prometheus.Labels, len(sdkLabels))
+	for _, k := range sdkLabels {
+		v := h.tags[k]
+		if _, ok := limitedTags[k]; ok && v != "" {
+			v = h.metrics.limiter.value(v)
+		}
+		labels[k] = v
+	}
+	return labels
+}
+
+func (m *Metrics) counterVec(name string) *prometheus.CounterVec {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if vec, ok := m.counters[name]; ok {
+		return vec
+	}
+	vec := prometheus.NewCounterVec(prometheus.CounterOpts{Name: name, Help: "Temporal SDK counter " + name + "."}, sdkLabels)
+	m.register(name, vec)
+	m.counters[name] = vec
+	return vec
+}
+
+func (m *Metrics) gaugeVec(name string) *prometheus.GaugeVec {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if vec, ok := m.gauges[name]; ok {
+		return vec
+	}
+	vec := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: name, Help: "Temporal SDK gauge " + name + "."}, sdkLabels)
+	m.register(name, vec)
+	m.gauges[name] = vec
+	return vec
+}
+
+func (m *Metrics) histogramVec(name string) *prometheus.HistogramVec {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if vec, ok := m.histograms[name]; ok {
+		return vec
+	}
+	vec := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    name,
+		Help:    "Temporal SDK timer " + name + " in seconds.",
+		Buckets: m.buckets,
+	}, sdkLabels)
+	m.register(name, vec)
+	m.histograms[name] = vec
+	return vec
+}
+
+// register adds the collector of an SDK metric. A collector that cannot be registered, e.g. because the
+// name is already used by another kind of metric, is logged and kept unregistered, so that recording
+// through it is a no-op instead of a panic in the SDK.
+func (m *Metrics) register(name string, c prometheus.Collector) {
+	if err := m.registry.Register(c); err != nil {
+		m.log.WithField("metric", name).WithError(err).Error("SDK metric not exported: register collector")
+	}
+}
+
+// sanitizeName replaces characters that are not valid in Prometheus metric and label names.
+func sanitizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || r == ':' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, name)
+}
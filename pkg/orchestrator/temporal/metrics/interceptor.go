@@ -0,0 +1,124 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.temporal.io/sdk/activity"
+	sdkInterceptor "go.temporal.io/sdk/interceptor"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	outcomeCompleted = "completed"
+	outcomeFailed    = "failed"
+	outcomeCanceled  = "canceled"
+)
+
+// interceptor records engine level metrics for activities, child workflows and signals.
+type interceptor struct {
+	sdkInterceptor.WorkerInterceptorBase
+	metrics *Metrics
+}
+
+// Interceptor returns the worker interceptor recording engine metrics,
+// to be set in worker.Options.Interceptors.
+//
+//nolint:ireturn // sdkInterceptor.WorkerInterceptor is the type expected by the SDK
+func (m *Metrics) Interceptor() sdkInterceptor.WorkerInterceptor {
+	return &interceptor{metrics: m}
+}
+
+//nolint:ireturn // implements sdkInterceptor.WorkerInterceptor
+func (i *interceptor) InterceptActivity(
+	_ context.Context,
+	next sdkInterceptor.ActivityInboundInterceptor,
+) sdkInterceptor.ActivityInboundInterceptor {
+	a := &activityInterceptor{metrics: i.metrics}
+	a.Next = next
+	return a
+}
+
+//nolint:ireturn // implements sdkInterceptor.WorkerInterceptor
+func (i *interceptor) InterceptWorkflow(
+	_ workflow.Context,
+	next sdkInterceptor.WorkflowInboundInterceptor,
+) sdkInterceptor.WorkflowInboundInterceptor {
+	w := &workflowInboundInterceptor{metrics: i.metrics}
+	w.Next = next
+	return w
+}
+
+type activityInterceptor struct {
+	sdkInterceptor.ActivityInboundInterceptorBase
+	metrics *Metrics
+}
+
+func (a *activityInterceptor) ExecuteActivity(
+	ctx context.Context,
+	in *sdkInterceptor.ExecuteActivityInput,
+) (interface{}, error) {
+	start := time.Now()
+	result, err := a.Next.ExecuteActivity(ctx, in)
+
+	outcome := outcomeCompleted
+	var canceledErr *temporal.CanceledError
+	switch {
+	case errors.As(err, &canceledErr) || errors.Is(err, context.Canceled):
+		outcome = outcomeCanceled
+	case err != nil:
+		outcome = outcomeFailed
+	}
+	a.metrics.activityDuration.
+		WithLabelValues(a.metrics.limiter.value(activity.GetInfo(ctx).ActivityType.Name), outcome).
+		Observe(time.Since(start).Seconds())
+
+	return result, err //nolint:wrapcheck // errors are passed through unchanged
+}
+
+type workflowInboundInterceptor struct {
+	sdkInterceptor.WorkflowInboundInterceptorBase
+	metrics *Metrics
+}
+
+func (w *workflowInboundInterceptor) Init(outbound sdkInterceptor.WorkflowOutboundInterceptor) error {
+	o := &workflowOutboundInterceptor{metrics: w.metrics}
+	o.Next = outbound
+	return w.Next.Init(o) //nolint:wrapcheck // errors are passed through unchanged
+}
+
+func (w *workflowInboundInterceptor) HandleSignal(ctx workflow.Context, in *sdkInterceptor.HandleSignalInput) error {
+	if !workflow.IsReplaying(ctx) {
+		w.metrics.signals.
+			WithLabelValues(
+				w.metrics.limiter.value(workflow.GetInfo(ctx).WorkflowType.Name),
+				w.metrics.signalLimiter.value(in.SignalName),
+			).
+			Inc()
+	}
+	return w.Next.HandleSignal(ctx, in) //nolint:wrapcheck // errors are passed through unchanged
+}
+
+type workflowOutboundInterceptor struct {
+	sdkInterceptor.WorkflowOutboundInterceptorBase
+	metrics *Metrics
+}
+
+//nolint:ireturn // implements sdkInterceptor.WorkflowOutboundInterceptor
+func (w *workflowOutboundInterceptor) ExecuteChildWorkflow(
+	ctx workflow.Context,
+	childWorkflowType string,
+	args ...interface{},
+) workflow.ChildWorkflowFuture {
+	if !workflow.IsReplaying(ctx) {
+		w.metrics.childWorkflows.
+			WithLabelValues(
+				w.metrics.limiter.value(workflow.GetInfo(ctx).WorkflowType.Name),
+				w.metrics.limiter.value(childWorkflowType),
+			).
+			Inc()
+	}
+	return w.Next.ExecuteChildWorkflow(ctx, childWorkflowType, args...)
+}
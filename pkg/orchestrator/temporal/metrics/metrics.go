@@ -0,0 +1,173 @@
+// Package metrics exports Temporal SDK and engine metrics in the Prometheus format.
+//
+// SDK metrics, including the workflow task latency reported as temporal_workflow_task_execution_latency,
+// are recorded through the handler returned by Metrics.Handler. Engine metrics, such as the activity
+// latency per ActivityDescriptor name, are recorded by the interceptor returned by Metrics.Interceptor.
+// Workflow and activity type label values are limited to the registered descriptors plus
+// Config.MaxLabelValues other values, signal names to Config.MaxLabelValues values;
+// everything else is reported as "other".
+package metrics
+
+import (
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger"
+	logModel "github.com/nash-567/goTemporalLoom/pkg/logger/model"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/model"
+)
+
+const (
+	namespace = "loom"
+
+	// OtherLabelValue replaces label values once the limit of distinct values is reached.
+	OtherLabelValue = "other"
+
+	defaultMaxLabelValues = 50
+)
+
+// Config configures the metrics subsystem.
+type Config struct {
+	// Registry receives all collectors. The default is a new registry.
+	Registry *prometheus.Registry
+
+	// Workflows lists the workflows whose names are always accepted as label values.
+	Workflows []model.WorkflowDescriptor
+
+	// Activities lists the activities whose names are always accepted as label values.
+	Activities []model.ActivityDescriptor
+
+	// MaxLabelValues is the number of distinct unregistered values accepted per label
+	// before values are replaced with OtherLabelValue. The default is 50.
+	MaxLabelValues int
+
+	// Buckets are the histogram buckets, in seconds, used for latencies.
+	// The default is prometheus.DefBuckets.
+	Buckets []float64
+
+	// Log receives the errors of SDK metrics that cannot be registered. The default logs to stderr.
+	Log logModel.Logger
+}
+
+// Metrics owns the Prometheus collectors for the SDK and the workflow engine.
+type Metrics struct {
+	registry      *prometheus.Registry
+	log           logModel.Logger
+	buckets       []float64
+	limiter       *labelLimiter
+	signalLimiter *labelLimiter
+
+	activityDuration *prometheus.HistogramVec
+	childWorkflows   *prometheus.CounterVec
+	signals          *prometheus.CounterVec
+
+	mu         sync.Mutex
+	counters   map[string]*prometheus.CounterVec
+	gauges     map[string]*prometheus.GaugeVec
+	histograms map[string]*prometheus.HistogramVec
+}
+
+// New creates the engine collectors and registers them with the configured registry.
+func New(config *Config) *Metrics {
+	registry := config.Registry
+	if registry == nil {
+		registry = prometheus.NewRegistry()
+	}
+	buckets := config.Buckets
+	if len(buckets) == 0 {
+		buckets = prometheus.DefBuckets
+	}
+	maxLabelValues := config.MaxLabelValues
+	if maxLabelValues <= 0 {
+		maxLabelValues = defaultMaxLabelValues
+	}
+
+	log := config.Log
+	if log == nil {
+		log = logger.NewSlogLogger(&logModel.Config{Output: os.Stderr, KeepSlogDefault: true})
+	}
+
+	known := make([]string, 0, len(config.Workflows)+len(config.Activities))
+	for _, w := range config.Workflows {
+		known = append(known, w.Name())
+	}
+	for _, a := range config.Activities {
+		known = append(known, a.Name())
+	}
+
+	m := &Metrics{
+		registry:      registry,
+		log:           log,
+		buckets:       buckets,
+		limiter:       newLabelLimiter(known, maxLabelValues),
+		signalLimiter: newLabelLimiter(nil, maxLabelValues),
+		counters:      make(map[string]*prometheus.CounterVec),
+		gauges:        make(map[string]*prometheus.GaugeVec),
+		histograms:    make(map[string]*prometheus.HistogramVec),
+		activityDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "activity_duration_seconds",
+			Help:      "Duration of activity attempts by activity and outcome.",
+			Buckets:   buckets,
+		}, []string{"activity", "outcome"}),
+		childWorkflows: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "child_workflows_started_total",
+			Help:      "Child workflows started by parent and child workflow type.",
+		}, []string{"workflow", "child_workflow"}),
+		signals: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "signals_received_total",
+			Help:      "Signals received by workflow type and signal name.",
+		}, []string{"workflow", "signal"}),
+	}
+	registry.MustRegister(m.activityDuration, m.childWorkflows, m.signals)
+	return m
+}
+
+// HTTPHandler returns the handler serving the registry in the Prometheus exposition format.
+func (m *Metrics) HTTPHandler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// labelLimiter bounds the number of distinct values used for a label.
+type labelLimiter struct {
+	mu    sync.Mutex
+	known map[string]struct{}
+	seen  map[string]struct{}
+	max   int
+}
+
+func newLabelLimiter(known []string, maxValues int) *labelLimiter {
+	l := &labelLimiter{
+		known: make(map[string]struct{}, len(known)),
+		seen:  make(map[string]struct{}),
+		max:   maxValues,
+	}
+	for _, k := range known {
+		l.known[k] = struct{}{}
+	}
+	return l
+}
+
+// value returns v if it is a registered name or one of the first max other values, OtherLabelValue otherwise.
+func (l *labelLimiter) value(v string) string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, ok := l.known[v]; ok {
+		return v
+	}
+	if _, ok := l.seen[v]; ok {
+		return v
+	}
+	if len(l.seen) >= l.max {
+		return OtherLabelValue
+	}
+	l.seen[v] = struct{}{}
+	return v
+}
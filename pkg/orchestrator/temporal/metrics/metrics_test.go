@@ -0,0 +1,198 @@
+package metrics_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdkInterceptor "go.temporal.io/sdk/interceptor"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger/logtest"
+	logModel "github.com/nash-567/goTemporalLoom/pkg/logger/model"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/metrics"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/model"
+)
+
+type descriptor string
+
+func (d descriptor) Name() string        { return string(d) }
+func (d descriptor) Description() string { return "test descriptor " + string(d) }
+
+type workflowDescriptor struct{ descriptor }
+
+func (d workflowDescriptor) GenerateWorkflowID(model.Params) (string, error) { return d.Name(), nil }
+
+func chargeActivity(_ context.Context) error { return nil }
+
+func childWorkflow(ctx workflow.Context) error {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{StartToCloseTimeout: time.Minute})
+	return workflow.ExecuteActivity(ctx, chargeActivity).Get(ctx, nil)
+}
+
+func parentWorkflow(ctx workflow.Context) error {
+	workflow.GetSignalChannel(ctx, "approve").Receive(ctx, nil)
+	return workflow.ExecuteChildWorkflow(ctx, childWorkflow).Get(ctx, nil)
+}
+
+func scrape(t *testing.T, m *metrics.Metrics) string {
+	t.Helper()
+	srv := httptest.NewServer(m.HTTPHandler())
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL) //nolint:noctx // test request
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestMetrics_Workflow(t *testing.T) {
+	t.Parallel()
+	m := metrics.New(&metrics.Config{
+		Activities: []model.ActivityDescriptor{descriptor("chargeActivity")},
+	})
+
+	var suite testsuite.WorkflowTestSuite
+	suite.SetMetricsHandler(m.Handler())
+	env := suite.NewTestWorkflowEnvironment()
+	env.SetWorkerOptions(worker.Options{Interceptors: []sdkInterceptor.WorkerInterceptor{m.Interceptor()}})
+	env.RegisterWorkflow(parentWorkflow)
+	env.RegisterWorkflow(childWorkflow)
+	env.RegisterActivity(chargeActivity)
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow("approve", nil)
+	}, time.Second)
+
+	env.ExecuteWorkflow(parentWorkflow)
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	body := scrape(t, m)
+	for _, want := range []string{
+		`loom_activity_duration_seconds_count{activity="chargeActivity",outcome="completed"} 1`,
+		`loom_child_workflows_started_total{child_workflow="childWorkflow",workflow="parentWorkflow"} 1`,
+		`loom_signals_received_total{signal="approve",workflow="parentWorkflow"} 1`,
+	} {
+		assert.Contains(t, body, want)
+	}
+}
+
+// sdkSeries returns the value of every SDK counter, gauge and histogram count gathered from registry,
+// keyed by the metric name and its non-empty labels, and the label names of every metric.
+func sdkSeries(t *testing.T, registry *prometheus.Registry) (map[string]float64, map[string][]string) {
+	t.Helper()
+	families, err := registry.Gather()
+	require.NoError(t, err)
+
+	series := make(map[string]float64)
+	labelNames := make(map[string][]string)
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			names := make([]string, 0, len(metric.GetLabel()))
+			labels := make([]string, 0, len(metric.GetLabel()))
+			for _, label := range metric.GetLabel() {
+				names = append(names, label.GetName())
+				if label.GetValue() != "" {
+					labels = append(labels, label.GetName()+"="+label.GetValue())
+				}
+			}
+			if previous, ok := labelNames[family.GetName()]; ok {
+				require.Equal(t, previous, names, "label names of %s", family.GetName())
+			}
+			labelNames[family.GetName()] = names
+
+			key := family.GetName() + "{" + strings.Join(labels, ",") + "}"
+			switch {
+			case metric.GetCounter() != nil:
+				series[key] = metric.GetCounter().GetValue()
+			case metric.GetGauge() != nil:
+				series[key] = metric.GetGauge().GetValue()
+			case metric.GetHistogram() != nil:
+				series[key] = float64(metric.GetHistogram().GetSampleCount())
+			}
+		}
+	}
+	return series, labelNames
+}
+
+func TestMetrics_HandlerLabelCardinality(t *testing.T) {
+	t.Parallel()
+	registry := prometheus.NewRegistry()
+	m := metrics.New(&metrics.Config{
+		Registry:       registry,
+		Workflows:      []model.WorkflowDescriptor{workflowDescriptor{descriptor("third")}},
+		MaxLabelValues: 1,
+	})
+	handler := m.Handler()
+
+	for _, workflowType := range []string{"first", "second", "third", "fourth"} {
+		handler.WithTags(map[string]string{"workflow_type": workflowType, "task-queue": "default"}).
+			Counter("temporal_workflow_completed").
+			Inc(1)
+	}
+	handler.Gauge("temporal_num_pollers").Update(2)
+
+	series, _ := sdkSeries(t, registry)
+	assert.Equal(t, map[string]float64{
+		"temporal_workflow_completed{task_queue=default,workflow_type=first}": 1,
+		"temporal_workflow_completed{task_queue=default,workflow_type=other}": 2,
+		"temporal_workflow_completed{task_queue=default,workflow_type=third}": 1,
+		"temporal_num_pollers{}": 2,
+	}, series)
+}
+
+func TestMetrics_HandlerLabelStability(t *testing.T) {
+	t.Parallel()
+	registry := prometheus.NewRegistry()
+	handler := metrics.New(&metrics.Config{Registry: registry}).Handler()
+
+	// the SDK records the same metric with different tags, e.g. from the client and from a worker.
+	latency := "temporal_request_latency"
+	handler.WithTags(map[string]string{"operation": "StartWorkflowExecution", "namespace": "default"}).
+		Timer(latency).
+		Record(time.Second)
+	handler.WithTags(map[string]string{"task_queue": "payments", "worker_type": "ActivityWorker"}).
+		WithTags(map[string]string{"operation": "PollActivityTaskQueue", "unknown": "dropped"}).
+		Timer(latency).
+		Record(time.Second)
+	handler.Timer(latency).Record(time.Second)
+
+	series, labelNames := sdkSeries(t, registry)
+	assert.Equal(t, map[string]float64{
+		"temporal_request_latency{namespace=default,operation=StartWorkflowExecution}":                             1,
+		"temporal_request_latency{operation=PollActivityTaskQueue,task_queue=payments,worker_type=ActivityWorker}": 1,
+		"temporal_request_latency{}": 1,
+	}, series)
+	assert.NotContains(t, labelNames[latency], "unknown")
+	assert.Contains(t, labelNames[latency], "workflow_type")
+}
+
+func TestMetrics_HandlerRegisterError(t *testing.T) {
+	t.Parallel()
+	log := logtest.New()
+	registry := prometheus.NewRegistry()
+	handler := metrics.New(&metrics.Config{Registry: registry, Log: log}).Handler()
+
+	handler.Gauge("temporal_sticky_cache_size").Update(1)
+	assert.NotPanics(t, func() {
+		handler.Counter("temporal_sticky_cache_size").Inc(1)
+		handler.Counter("temporal_sticky_cache_size").Inc(1)
+	})
+
+	require.Len(t, log.Find("SDK metric not exported: register collector"), 1)
+	log.AssertLogged(t, logModel.ErrorLevel, "SDK metric not exported: register collector",
+		logModel.Fields{"metric": "temporal_sticky_cache_size"})
+	series, _ := sdkSeries(t, registry)
+	assert.Equal(t, map[string]float64{"temporal_sticky_cache_size{}": 1}, series)
+}
@@ -1,12 +1,29 @@
 package model
 
 import (
+	"errors"
+	"fmt"
+
 	"go.temporal.io/sdk/temporal"
 	sdkWorkflow "go.temporal.io/sdk/workflow"
 )
 
+// ErrNotTemporalContext is the panic value of ToTemporalContext for a Context that does not wrap a Temporal
+// workflow context, such as the Context of the fake engine.
+var ErrNotTemporalContext = errors.New("not a Temporal workflow context")
+
+// ToTemporalContext returns the SDK workflow context wrapped by ctx. The Temporal workflow engine only
+// receives the contexts it created, so any other Context is a programming error: ToTemporalContext panics
+// with an error wrapping ErrNotTemporalContext, e.g. when a workflow tested with the fake engine reaches for
+// the SDK directly.
+//
+//nolint:ireturn // sdkWorkflow.Context is the type expected by the SDK
 func ToTemporalContext(ctx Context) sdkWorkflow.Context {
-	return ctx.GetParentContext().(sdkWorkflow.Context) //nolint:forcetypeassert
+	temporalCtx, ok := ctx.GetParentContext().(sdkWorkflow.Context)
+	if !ok {
+		panic(fmt.Errorf("%w: %T", ErrNotTemporalContext, ctx))
+	}
+	return temporalCtx
 }
 
 func toTemporalRetryPolicy(r *RetryPolicy) *temporal.RetryPolicy {
@@ -35,3 +52,22 @@ func ToTemporalActivityOptions(o *ActivityOptions) sdkWorkflow.ActivityOptions {
 		DisableEagerExecution:  o.DisableEagerExecution,
 	}
 }
+
+// ToTemporalChildWorkflowOptions converts o into SDK options for the child workflow with the given ID.
+// StartDelay is not applied as the SDK does not support it for child workflows.
+func ToTemporalChildWorkflowOptions(workflowID string, o *ChildWorkflowOptions) sdkWorkflow.ChildWorkflowOptions {
+	var retryPolicy *temporal.RetryPolicy
+	if o.RetryPolicy != nil {
+		retryPolicy = toTemporalRetryPolicy(o.RetryPolicy)
+	}
+	return sdkWorkflow.ChildWorkflowOptions{
+		WorkflowID:               workflowID,
+		TaskQueue:                o.TaskQueue,
+		WorkflowExecutionTimeout: o.WorkflowExecutionTimeout,
+		WorkflowRunTimeout:       o.WorkflowRunTimeout,
+		WorkflowTaskTimeout:      o.WorkflowTaskTimeout,
+		RetryPolicy:              retryPolicy,
+		CronSchedule:             o.CronSchedule,
+		Memo:                     o.Memo,
+	}
+}
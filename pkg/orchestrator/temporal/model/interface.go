@@ -14,7 +14,9 @@ type (
 	// it is a wrapper over dangling workflow functions.
 	WorkflowEngine interface {
 		// GetLogger returns a logger to be used in the workflow's context.
-		GetLogger(ctx Context) logModel.KeyValLogger
+		// The logger is tagged with the workflow ID, run ID, type and attempt, and it is silent while the
+		// workflow is replaying its history.
+		GetLogger(ctx Context) logModel.Logger
 
 		// ExecuteActivity executes a workflow activity.
 		// The activity is executed asynchronously and the result is returned as a Future.
@@ -37,6 +39,10 @@ type (
 		// The workflow will be resumed after the specified duration.
 		Sleep(ctx Context, d time.Duration) error
 
+		// Now returns the current workflow time, which is deterministic across replays.
+		// It must be used instead of time.Now in workflow code.
+		Now(ctx Context) time.Time
+
 		// GetSignalChannel returns a channel to receive signals for the workflow.
 		// The signalName is the name of the signal to receive.
 		// The signal channel can be used to receive signals from the workflow.
@@ -141,6 +147,11 @@ type (
 		GenerateWorkflowID(in Params) (string, error)
 	}
 
+	// ActivityDescriptor defines the metadata and identification methods for an activity.
+	ActivityDescriptor interface {
+		descriptor
+	}
+
 	descriptor interface {
 		// Name returns the name of the workflow/activity.
 		Name() string
@@ -0,0 +1,157 @@
+package temporal
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/model"
+)
+
+var errInvalidWorkflow = errors.New("workflow must be a function taking a model.Context as first parameter")
+
+//nolint:gochecknoglobals // reflected types used to adapt workflow functions
+var (
+	modelContextType    = reflect.TypeOf((*model.Context)(nil)).Elem()
+	temporalContextType = reflect.TypeOf((*workflow.Context)(nil)).Elem()
+)
+
+type (
+	// WorkflowRegisterer registers workflows under explicit names.
+	// It is implemented by worker.Worker, worker.WorkflowReplayer and testsuite.TestWorkflowEnvironment.
+	WorkflowRegisterer interface {
+		RegisterWorkflowWithOptions(w interface{}, options workflow.RegisterOptions)
+	}
+
+	// ActivityRegisterer registers activities under explicit names.
+	// It is implemented by worker.Worker and testsuite.TestWorkflowEnvironment.
+	ActivityRegisterer interface {
+		RegisterActivityWithOptions(a interface{}, options activity.RegisterOptions)
+	}
+
+	// Registerer registers both workflows and activities.
+	Registerer interface {
+		WorkflowRegisterer
+		ActivityRegisterer
+	}
+
+	// Registry collects the descriptor-based workflows and activities of a worker, so the same set
+	// can be registered into workers, test environments and replayers.
+	Registry struct {
+		workflows  []workflowRegistration
+		activities []activityRegistration
+	}
+
+	workflowRegistration struct {
+		descriptor model.WorkflowDescriptor
+		fn         interface{}
+	}
+
+	activityRegistration struct {
+		descriptor model.ActivityDescriptor
+		fn         interface{}
+	}
+)
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// AddWorkflow adds a workflow taking a model.Context as first parameter under the descriptor's name.
+func (r *Registry) AddWorkflow(descriptor model.WorkflowDescriptor, workflowFn interface{}) error {
+	fn, err := AdaptWorkflow(workflowFn)
+	if err != nil {
+		return fmt.Errorf("add workflow %s: %w", descriptor.Name(), err)
+	}
+	r.workflows = append(r.workflows, workflowRegistration{descriptor: descriptor, fn: fn})
+	return nil
+}
+
+// AddActivity adds an activity under the descriptor's name.
+func (r *Registry) AddActivity(descriptor model.ActivityDescriptor, activityFn interface{}) {
+	r.activities = append(r.activities, activityRegistration{descriptor: descriptor, fn: activityFn})
+}
+
+// Workflows returns the descriptors of the workflows added to the registry, in order.
+func (r *Registry) Workflows() []model.WorkflowDescriptor {
+	descriptors := make([]model.WorkflowDescriptor, 0, len(r.workflows))
+	for _, w := range r.workflows {
+		descriptors = append(descriptors, w.descriptor)
+	}
+	return descriptors
+}
+
+// Activities returns the descriptors of the activities added to the registry, in order.
+func (r *Registry) Activities() []model.ActivityDescriptor {
+	descriptors := make([]model.ActivityDescriptor, 0, len(r.activities))
+	for _, a := range r.activities {
+		descriptors = append(descriptors, a.descriptor)
+	}
+	return descriptors
+}
+
+// RegisterWorkflows registers every workflow of the registry, e.g. into a replayer.
+func (r *Registry) RegisterWorkflows(target WorkflowRegisterer) {
+	for _, w := range r.workflows {
+		target.RegisterWorkflowWithOptions(w.fn, workflow.RegisterOptions{Name: w.descriptor.Name()})
+	}
+}
+
+// Register registers every workflow and activity of the registry.
+func (r *Registry) Register(target Registerer) {
+	r.RegisterWorkflows(target)
+	for _, a := range r.activities {
+		RegisterActivity(target, a.descriptor, a.fn)
+	}
+}
+
+// RegisterWorkflow registers a workflow written against model.Context under the descriptor's name.
+// workflowFn must take a model.Context as first parameter; it is adapted to take a workflow.Context.
+func RegisterWorkflow(target WorkflowRegisterer, descriptor model.WorkflowDescriptor, workflowFn interface{}) error {
+	fn, err := AdaptWorkflow(workflowFn)
+	if err != nil {
+		return fmt.Errorf("register workflow %s: %w", descriptor.Name(), err)
+	}
+	target.RegisterWorkflowWithOptions(fn, workflow.RegisterOptions{Name: descriptor.Name()})
+	return nil
+}
+
+// RegisterActivity registers an activity under the descriptor's name.
+func RegisterActivity(target ActivityRegisterer, descriptor model.ActivityDescriptor, activityFn interface{}) {
+	target.RegisterActivityWithOptions(activityFn, activity.RegisterOptions{Name: descriptor.Name()})
+}
+
+// AdaptWorkflow turns a function taking a model.Context as first parameter into an equivalent
+// function taking a workflow.Context, which is what the SDK expects.
+func AdaptWorkflow(workflowFn interface{}) (interface{}, error) {
+	fn := reflect.ValueOf(workflowFn)
+	fnType := fn.Type()
+	if fnType.Kind() != reflect.Func || fnType.NumIn() == 0 || fnType.In(0) != modelContextType {
+		return nil, fmt.Errorf("%w: got %s", errInvalidWorkflow, fnType)
+	}
+
+	in := make([]reflect.Type, fnType.NumIn())
+	in[0] = temporalContextType
+	for i := 1; i < fnType.NumIn(); i++ {
+		in[i] = fnType.In(i)
+	}
+	out := make([]reflect.Type, fnType.NumOut())
+	for i := range out {
+		out[i] = fnType.Out(i)
+	}
+
+	adaptedType := reflect.FuncOf(in, out, fnType.IsVariadic())
+	adapted := reflect.MakeFunc(adaptedType, func(args []reflect.Value) []reflect.Value {
+		ctx, _ := args[0].Interface().(workflow.Context)
+		args[0] = reflect.ValueOf(newContext(ctx))
+		if fnType.IsVariadic() {
+			return fn.CallSlice(args)
+		}
+		return fn.Call(args)
+	})
+	return adapted.Interface(), nil
+}
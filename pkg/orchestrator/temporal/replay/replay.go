@@ -0,0 +1,153 @@
+// Package replay checks workflow determinism by replaying recorded histories against the current code.
+//
+// Histories are the JSON files produced by `temporal workflow show --output json` or downloaded from the UI.
+// Every history is replayed through a worker.WorkflowReplayer holding the workflows of a temporal.Registry;
+// a history fails when the current code no longer produces the commands recorded in it.
+package replay
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/log"
+	"go.temporal.io/sdk/worker"
+
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal"
+)
+
+var errEmptyHistory = errors.New("history has no WorkflowExecutionStarted event")
+
+type (
+	// History is a workflow history loaded from a JSON file.
+	History struct {
+		Path         string
+		WorkflowType string
+		History      *historypb.History
+	}
+
+	// Result is the outcome of replaying a single history.
+	Result struct {
+		Path         string
+		WorkflowType string
+		// Err holds the replay failure, including non-determinism details, or nil when the replay passed.
+		Err error
+	}
+)
+
+// Passed reports whether the history replayed without error.
+func (r *Result) Passed() bool {
+	return r.Err == nil
+}
+
+// String formats the result as a PASS or FAIL line.
+func (r *Result) String() string {
+	if r.Passed() {
+		return fmt.Sprintf("PASS %s (%s)", r.Path, r.WorkflowType)
+	}
+	return fmt.Sprintf("FAIL %s (%s): %v", r.Path, r.WorkflowType, r.Err)
+}
+
+// Load reads histories from the given JSON files and directories.
+// Directories are walked recursively for *.json files, which are returned sorted by path.
+func Load(paths ...string) ([]History, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("stat history path: %w", err)
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		err = filepath.WalkDir(path, func(file string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() && strings.EqualFold(filepath.Ext(file), ".json") {
+				files = append(files, file)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("walk history directory: %w", err)
+		}
+	}
+	sort.Strings(files)
+
+	histories := make([]History, 0, len(files))
+	for _, file := range files {
+		history, err := loadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		histories = append(histories, history)
+	}
+	return histories, nil
+}
+
+func loadFile(path string) (History, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return History{}, fmt.Errorf("open history: %w", err)
+	}
+	defer f.Close()
+
+	h, err := client.HistoryFromJSON(f, client.HistoryJSONOptions{})
+	if err != nil {
+		return History{}, fmt.Errorf("decode history %s: %w", path, err)
+	}
+	events := h.GetEvents()
+	if len(events) == 0 || events[0].GetWorkflowExecutionStartedEventAttributes() == nil {
+		return History{}, fmt.Errorf("%s: %w", path, errEmptyHistory)
+	}
+	return History{
+		Path:         path,
+		WorkflowType: events[0].GetWorkflowExecutionStartedEventAttributes().GetWorkflowType().GetName(),
+		History:      h,
+	}, nil
+}
+
+// Run replays every history against the workflows of the registry and returns one result per history.
+// Replayer logs are discarded when logger is nil.
+func Run(registry *temporal.Registry, histories []History, logger log.Logger) []Result {
+	if logger == nil {
+		logger = discardLogger()
+	}
+	results := make([]Result, 0, len(histories))
+	for _, h := range histories {
+		results = append(results, Result{
+			Path:         h.Path,
+			WorkflowType: h.WorkflowType,
+			Err:          replay(registry, h, logger),
+		})
+	}
+	return results
+}
+
+// replay uses a fresh replayer per history so that histories sharing a workflow ID do not interfere.
+func replay(registry *temporal.Registry, h History, logger log.Logger) error {
+	replayer, err := worker.NewWorkflowReplayerWithOptions(worker.WorkflowReplayerOptions{})
+	if err != nil {
+		return fmt.Errorf("create replayer: %w", err)
+	}
+	registry.RegisterWorkflows(replayer)
+	if err := replayer.ReplayWorkflowHistory(logger, h.History); err != nil {
+		return fmt.Errorf("replay: %w", err)
+	}
+	return nil
+}
+
+//nolint:ireturn // the SDK replayer takes its own logger interface
+func discardLogger() log.Logger {
+	return log.NewStructuredLogger(slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
@@ -0,0 +1,104 @@
+package replay_test
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger"
+	logModel "github.com/nash-567/goTemporalLoom/pkg/logger/model"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/model"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/replay"
+)
+
+type descriptor string
+
+func (d descriptor) Name() string        { return string(d) }
+func (d descriptor) Description() string { return string(d) }
+func (d descriptor) GenerateWorkflowID(in model.Params) (string, error) {
+	return string(d) + "-" + in.String(), nil
+}
+
+// chargeWorkflow executes the given activity, which is what testdata/charge.json recorded for ChargeActivity.
+func chargeWorkflow(engine model.WorkflowEngine, activity string) func(ctx model.Context) error {
+	return func(ctx model.Context) error {
+		ctx = engine.WithActivityOptions(ctx, model.ActivityOptions{
+			ScheduleToStartTimeout: time.Minute,
+			StartToCloseTimeout:    time.Minute,
+			HeartbeatTimeout:       20 * time.Second,
+		})
+		return engine.ExecuteActivity(ctx, activity, 2, "test", true).Get(ctx, nil)
+	}
+}
+
+func newRegistry(t *testing.T, workflowName, activity string) *temporal.Registry {
+	t.Helper()
+	registry := temporal.NewRegistry()
+	engine := temporal.NewWorkflowEngine(logger.NewSlogLogger(&logModel.Config{Output: io.Discard}))
+	require.NoError(t, registry.AddWorkflow(descriptor(workflowName), chargeWorkflow(engine, activity)))
+	return registry
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+	histories, err := replay.Load("testdata")
+	require.NoError(t, err)
+	require.Len(t, histories, 1)
+	assert.Equal(t, "testdata/charge.json", histories[0].Path)
+	assert.Equal(t, "ChargeWorkflow", histories[0].WorkflowType)
+	assert.Len(t, histories[0].History.GetEvents(), 11)
+
+	_, err = replay.Load("testdata/missing.json")
+	require.Error(t, err)
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		desc         string
+		workflowName string
+		activity     string
+		wantErr      string
+	}{
+		{
+			desc:         "deterministic",
+			workflowName: "ChargeWorkflow",
+			activity:     "ChargeActivity",
+		},
+		{
+			desc:         "activity changed",
+			workflowName: "ChargeWorkflow",
+			activity:     "RefundActivity",
+			wantErr:      "nondeterministic",
+		},
+		{
+			desc:         "workflow not registered",
+			workflowName: "OtherWorkflow",
+			activity:     "ChargeActivity",
+			wantErr:      "ChargeWorkflow",
+		},
+	}
+	histories, err := replay.Load("testdata/charge.json")
+	require.NoError(t, err)
+
+	for _, tC := range tests {
+		tt := tC
+		t.Run(tt.desc, func(t *testing.T) {
+			t.Parallel()
+			results := replay.Run(newRegistry(t, tt.workflowName, tt.activity), histories, nil)
+
+			require.Len(t, results, 1)
+			if tt.wantErr == "" {
+				assert.True(t, results[0].Passed(), results[0].String())
+				return
+			}
+			require.False(t, results[0].Passed())
+			assert.Contains(t, results[0].String(), "FAIL testdata/charge.json (ChargeWorkflow)")
+			assert.Contains(t, results[0].Err.Error(), tt.wantErr)
+		})
+	}
+}
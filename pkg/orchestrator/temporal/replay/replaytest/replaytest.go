@@ -0,0 +1,28 @@
+// Package replaytest provides the Go test helper of the replay package, kept apart so that the testing
+// package is not linked into binaries using replay.
+package replaytest
+
+import (
+	"testing"
+
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/replay"
+)
+
+// RequireDeterministic replays the histories found at paths and fails the test for every history
+// that does not replay, reporting the non-determinism details.
+func RequireDeterministic(t testing.TB, registry *temporal.Registry, paths ...string) {
+	t.Helper()
+	histories, err := replay.Load(paths...)
+	if err != nil {
+		t.Fatalf("load histories: %v", err)
+	}
+	if len(histories) == 0 {
+		t.Fatalf("no histories found in %v", paths)
+	}
+	for _, result := range replay.Run(registry, histories, nil) {
+		if !result.Passed() {
+			t.Error(result.String())
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package replaytest_test
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger"
+	logModel "github.com/nash-567/goTemporalLoom/pkg/logger/model"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/model"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/replay/replaytest"
+)
+
+type descriptor string
+
+func (d descriptor) Name() string        { return string(d) }
+func (d descriptor) Description() string { return string(d) }
+func (d descriptor) GenerateWorkflowID(in model.Params) (string, error) {
+	return string(d) + "-" + in.String(), nil
+}
+
+// chargeWorkflow executes ChargeActivity, as recorded in ../testdata/charge.json.
+func chargeWorkflow(engine model.WorkflowEngine) func(ctx model.Context) error {
+	return func(ctx model.Context) error {
+		ctx = engine.WithActivityOptions(ctx, model.ActivityOptions{
+			ScheduleToStartTimeout: time.Minute,
+			StartToCloseTimeout:    time.Minute,
+			HeartbeatTimeout:       20 * time.Second,
+		})
+		return engine.ExecuteActivity(ctx, "ChargeActivity", 2, "test", true).Get(ctx, nil)
+	}
+}
+
+func TestRequireDeterministic(t *testing.T) {
+	t.Parallel()
+	registry := temporal.NewRegistry()
+	engine := temporal.NewWorkflowEngine(logger.NewSlogLogger(&logModel.Config{Output: io.Discard}))
+	require.NoError(t, registry.AddWorkflow(descriptor("ChargeWorkflow"), chargeWorkflow(engine)))
+
+	replaytest.RequireDeterministic(t, registry, "../testdata")
+}
@@ -0,0 +1,141 @@
+// Package temporaltest runs workflows written against model.Context and model.WorkflowEngine in the
+// SDK's time-skipping test environment.
+package temporaltest
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger"
+	logModel "github.com/nash-567/goTemporalLoom/pkg/logger/model"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/model"
+)
+
+// Harness wraps a testsuite.TestWorkflowEnvironment for descriptor-based workflows and activities.
+type Harness struct {
+	t      testing.TB
+	env    *testsuite.TestWorkflowEnvironment
+	engine model.WorkflowEngine
+}
+
+// New returns a Harness backed by a new TestWorkflowEnvironment, whose engine discards workflow logs.
+// Activity mock expectations are asserted when the test completes.
+func New(t testing.TB) *Harness {
+	t.Helper()
+	return NewWithLogger(t, logger.NewSlogLogger(&logModel.Config{Output: io.Discard, KeepSlogDefault: true}))
+}
+
+// NewWithLogger returns a Harness whose engine writes workflow logs through log.
+func NewWithLogger(t testing.TB, log *logger.SlogLogger) *Harness {
+	t.Helper()
+	var suite testsuite.WorkflowTestSuite
+	h := &Harness{
+		t:      t,
+		env:    suite.NewTestWorkflowEnvironment(),
+		engine: temporal.NewWorkflowEngine(log),
+	}
+	t.Cleanup(func() { h.env.AssertExpectations(t) })
+	return h
+}
+
+// Env returns the underlying environment, for what the harness does not cover.
+func (h *Harness) Env() *testsuite.TestWorkflowEnvironment {
+	return h.env
+}
+
+// Engine returns the SDK-backed model.WorkflowEngine to be injected into the workflows under test.
+//
+//nolint:ireturn // workflows only depend on the model interface
+func (h *Harness) Engine() model.WorkflowEngine {
+	return h.engine
+}
+
+// RegisterWorkflow registers a workflow taking a model.Context under the descriptor's name.
+func (h *Harness) RegisterWorkflow(descriptor model.WorkflowDescriptor, workflowFn interface{}) {
+	h.t.Helper()
+	require.NoError(h.t, temporal.RegisterWorkflow(h.env, descriptor, workflowFn))
+}
+
+// Register registers every workflow and activity of the registry.
+func (h *Harness) Register(registry *temporal.Registry) {
+	registry.Register(h.env)
+}
+
+// RegisterActivity registers an activity under the descriptor's name.
+func (h *Harness) RegisterActivity(descriptor model.ActivityDescriptor, activityFn interface{}) {
+	temporal.RegisterActivity(h.env, descriptor, activityFn)
+}
+
+// SignalAfter sends a signal to the running workflow once delay has elapsed on the test clock.
+func (h *Harness) SignalAfter(delay time.Duration, signalName string, value interface{}) {
+	h.env.RegisterDelayedCallback(func() {
+		h.env.SignalWorkflow(signalName, value)
+	}, delay)
+}
+
+// ExecuteWorkflow runs the workflow registered under the descriptor's name to completion.
+func (h *Harness) ExecuteWorkflow(descriptor model.WorkflowDescriptor, args ...interface{}) {
+	h.env.ExecuteWorkflow(descriptor.Name(), args...)
+}
+
+// RequireError asserts that the workflow completed with an error containing msg.
+func (h *Harness) RequireError(msg string) {
+	h.t.Helper()
+	require.True(h.t, h.env.IsWorkflowCompleted(), "workflow not completed")
+	err := h.env.GetWorkflowError()
+	require.Error(h.t, err)
+	assert.Contains(h.t, err.Error(), msg)
+}
+
+// MockActivity replaces the single-input activity registered under the descriptor's name with fn.
+// The activity must have been registered with the same signature as fn.
+func MockActivity[In, Out any](
+	h *Harness, descriptor model.ActivityDescriptor, fn func(ctx context.Context, in In) (Out, error),
+) {
+	h.env.OnActivity(descriptor.Name(), mock.Anything, mock.Anything).Return(fn)
+}
+
+// MockActivityResult makes the single-input activity registered under the descriptor's name return out and err.
+func MockActivityResult[Out any](h *Harness, descriptor model.ActivityDescriptor, out Out, err error) {
+	h.env.OnActivity(descriptor.Name(), mock.Anything, mock.Anything).Return(out, err)
+}
+
+// Result asserts that the workflow completed successfully and returns its decoded result.
+func Result[T any](h *Harness) T {
+	h.t.Helper()
+	require.True(h.t, h.env.IsWorkflowCompleted(), "workflow not completed")
+	require.NoError(h.t, h.env.GetWorkflowError())
+	var result T
+	require.NoError(h.t, h.env.GetWorkflowResult(&result))
+	return result
+}
+
+// RequireResult asserts that the workflow completed successfully with the expected result.
+func RequireResult[T any](h *Harness, expected T) {
+	h.t.Helper()
+	assert.Equal(h.t, expected, Result[T](h))
+}
+
+// Query runs a query against the workflow and returns its decoded result.
+func Query[T any](h *Harness, queryType string, args ...interface{}) T {
+	h.t.Helper()
+	value, err := h.env.QueryWorkflow(queryType, args...)
+	require.NoError(h.t, err)
+	var result T
+	require.NoError(h.t, value.Get(&result))
+	return result
+}
+
+// RequireQuery asserts that a query against the workflow returns the expected result.
+func RequireQuery[T any](h *Harness, queryType string, expected T, args ...interface{}) {
+	h.t.Helper()
+	assert.Equal(h.t, expected, Query[T](h, queryType, args...))
+}
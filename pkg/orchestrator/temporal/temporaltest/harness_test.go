@@ -0,0 +1,172 @@
+package temporaltest_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nash-567/goTemporalLoom/pkg/logger"
+	logModel "github.com/nash-567/goTemporalLoom/pkg/logger/model"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/model"
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/temporaltest"
+)
+
+type order struct {
+	ID     string `json:"id"`
+	Amount int    `json:"amount"`
+}
+
+func (o *order) Marshal() ([]byte, error) { return json.Marshal(o) }
+func (o *order) String() string           { return o.ID }
+
+type descriptor string
+
+func (d descriptor) Name() string        { return string(d) }
+func (d descriptor) Description() string { return string(d) }
+func (d descriptor) GenerateWorkflowID(in model.Params) (string, error) {
+	return string(d) + "-" + in.String(), nil
+}
+
+const (
+	orderWorkflowDescriptor    = descriptor("OrderWorkflow")
+	shipmentWorkflowDescriptor = descriptor("ShipmentWorkflow")
+	chargeActivityDescriptor   = descriptor("ChargeActivity")
+)
+
+func chargeActivity(_ context.Context, in order) (string, error) {
+	return fmt.Sprintf("receipt-%d", in.Amount), nil
+}
+
+func shipmentWorkflow(_ model.Context, in *order) (string, error) {
+	return "track-" + in.ID, nil
+}
+
+func orderWorkflow(engine model.WorkflowEngine) func(ctx model.Context, in order) (string, error) {
+	return func(ctx model.Context, in order) (string, error) {
+		engine.GetLogger(ctx).WithField("order_id", in.ID).Info("order received")
+		status := "received"
+		if err := engine.SetQueryHandler(ctx, "status", func() (string, error) { return status, nil }); err != nil {
+			return "", err
+		}
+		ctx = engine.WithActivityOptions(ctx, model.ActivityOptions{StartToCloseTimeout: time.Minute})
+
+		var receipt string
+		if err := engine.ExecuteActivity(ctx, chargeActivityDescriptor.Name(), in).Get(ctx, &receipt); err != nil {
+			return "", err
+		}
+
+		var approver string
+		if ok, _ := engine.GetSignalChannel(ctx, "approve").ReceiveWithTimeout(ctx, time.Hour, &approver); !ok {
+			return "", errors.New("approval timed out")
+		}
+		status = "approved"
+
+		child, err := engine.ExecuteChildWorkflow(ctx, model.ChildWorkflowOptions{}, shipmentWorkflowDescriptor, &in)
+		if err != nil {
+			return "", err
+		}
+		var tracking string
+		if err := child.Get(ctx, &tracking); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s/%s/%s", receipt, approver, tracking), nil
+	}
+}
+
+func newHarness(t *testing.T) *temporaltest.Harness {
+	t.Helper()
+	h := temporaltest.New(t)
+	h.RegisterWorkflow(orderWorkflowDescriptor, orderWorkflow(h.Engine()))
+	h.RegisterWorkflow(shipmentWorkflowDescriptor, shipmentWorkflow)
+	h.RegisterActivity(chargeActivityDescriptor, chargeActivity)
+	return h
+}
+
+func TestHarness(t *testing.T) {
+	t.Parallel()
+	h := newHarness(t)
+	h.SignalAfter(30*time.Minute, "approve", "alice")
+	h.Env().RegisterDelayedCallback(func() {
+		temporaltest.RequireQuery(h, "status", "received")
+	}, time.Minute)
+
+	h.ExecuteWorkflow(orderWorkflowDescriptor, order{ID: "o-1", Amount: 42})
+
+	temporaltest.RequireResult(h, "receipt-42/alice/track-o-1")
+	temporaltest.RequireQuery(h, "status", "approved")
+}
+
+func TestHarness_WorkflowLogger(t *testing.T) {
+	t.Parallel()
+	output := new(strings.Builder)
+	h := temporaltest.NewWithLogger(t, logger.NewSlogLogger(&logModel.Config{Output: output}))
+	h.RegisterWorkflow(orderWorkflowDescriptor, orderWorkflow(h.Engine()))
+	h.RegisterActivity(chargeActivityDescriptor, chargeActivity)
+
+	h.ExecuteWorkflow(orderWorkflowDescriptor, order{ID: "o-4"})
+
+	h.RequireError("approval timed out")
+	for _, field := range []string{
+		`"msg":"order received"`,
+		`"order_id":"o-4"`,
+		`"workflow_type":"OrderWorkflow"`,
+		`"workflow_id":"default-test-workflow-id"`,
+		`"run_id":"default-test-run-id"`,
+		`"attempt":1`,
+	} {
+		assert.Contains(t, output.String(), field)
+	}
+}
+
+func TestHarness_MockActivity(t *testing.T) {
+	t.Parallel()
+	h := newHarness(t)
+	temporaltest.MockActivity(h, chargeActivityDescriptor, func(_ context.Context, in order) (string, error) {
+		return "mocked-" + in.ID, nil
+	})
+	h.SignalAfter(time.Minute, "approve", "bob")
+
+	h.ExecuteWorkflow(orderWorkflowDescriptor, order{ID: "o-2"})
+
+	temporaltest.RequireResult(h, "mocked-o-2/bob/track-o-2")
+}
+
+func TestHarness_Errors(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		desc    string
+		setup   func(h *temporaltest.Harness)
+		wantErr string
+	}{
+		{
+			desc: "activity failure",
+			setup: func(h *temporaltest.Harness) {
+				temporaltest.MockActivityResult(h, chargeActivityDescriptor, "", errors.New("payment declined"))
+			},
+			wantErr: "payment declined",
+		},
+		{
+			desc:    "approval timeout",
+			setup:   func(*temporaltest.Harness) {},
+			wantErr: "approval timed out",
+		},
+	}
+	for _, tC := range tests {
+		tt := tC
+		t.Run(tt.desc, func(t *testing.T) {
+			t.Parallel()
+			h := newHarness(t)
+			tt.setup(h)
+
+			h.ExecuteWorkflow(orderWorkflowDescriptor, order{ID: "o-3"})
+
+			h.RequireError(tt.wantErr)
+		})
+	}
+}
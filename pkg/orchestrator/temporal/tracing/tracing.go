@@ -0,0 +1,176 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	sdkInterceptor "go.temporal.io/sdk/interceptor"
+)
+
+const (
+	// HeaderKey is the Temporal header field carrying the serialized trace context.
+	HeaderKey = "_tracer-data"
+
+	instrumentationName = "github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/tracing"
+)
+
+var errInvalidSpanContext = errors.New("header does not contain a valid span context")
+
+// Config configures the tracing interceptor.
+type Config struct {
+	// TracerProvider creates the tracer used for all spans. The default is the global provider.
+	TracerProvider trace.TracerProvider
+
+	// Propagator serializes span contexts into Temporal headers.
+	// The default is the W3C trace context propagator.
+	Propagator propagation.TextMapPropagator
+
+	// DisableSignalTracing disables spans for signals.
+	DisableSignalTracing bool
+
+	// DisableQueryTracing disables spans for queries.
+	DisableQueryTracing bool
+}
+
+type spanContextKey struct{}
+
+// tracer implements the Tracer of the SDK tracing interceptor on top of OpenTelemetry.
+type tracer struct {
+	sdkInterceptor.BaseTracer
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+	options    sdkInterceptor.TracerOptions
+}
+
+// tracerSpanRef is a span received from a remote process through a Temporal header.
+type tracerSpanRef struct {
+	trace.SpanContext
+}
+
+// tracerSpan is a span started by this process.
+type tracerSpan struct {
+	trace.Span
+}
+
+// NewInterceptor returns an interceptor that creates spans for starting and running workflows,
+// scheduling and running activities, child workflows, signals and queries. The trace context is
+// carried between processes in Temporal headers, so the interceptor has to be set on both
+// clients (client.Options.Interceptors) and workers (worker.Options.Interceptors).
+//
+//nolint:ireturn // sdkInterceptor.Interceptor is the type expected by the SDK
+func NewInterceptor(config *Config) sdkInterceptor.Interceptor {
+	provider := config.TracerProvider
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+	propagator := config.Propagator
+	if propagator == nil {
+		propagator = propagation.TraceContext{}
+	}
+
+	return sdkInterceptor.NewTracingInterceptor(&tracer{
+		tracer:     provider.Tracer(instrumentationName),
+		propagator: propagator,
+		options: sdkInterceptor.TracerOptions{
+			SpanContextKey:       spanContextKey{},
+			HeaderKey:            HeaderKey,
+			DisableSignalTracing: config.DisableSignalTracing,
+			DisableQueryTracing:  config.DisableQueryTracing,
+		},
+	})
+}
+
+func (t *tracer) Options() sdkInterceptor.TracerOptions {
+	return t.options
+}
+
+//nolint:ireturn // implements sdkInterceptor.Tracer
+func (t *tracer) UnmarshalSpan(m map[string]string) (sdkInterceptor.TracerSpanRef, error) {
+	ctx := t.propagator.Extract(context.Background(), propagation.MapCarrier(m))
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return nil, errInvalidSpanContext
+	}
+	return &tracerSpanRef{SpanContext: spanContext}, nil
+}
+
+func (t *tracer) MarshalSpan(span sdkInterceptor.TracerSpan) (map[string]string, error) {
+	s, ok := span.(*tracerSpan)
+	if !ok {
+		return nil, fmt.Errorf("unexpected span type %T", span)
+	}
+	carrier := propagation.MapCarrier{}
+	t.propagator.Inject(trace.ContextWithSpan(context.Background(), s.Span), carrier)
+	return carrier, nil
+}
+
+//nolint:ireturn // implements sdkInterceptor.Tracer
+func (t *tracer) SpanFromContext(ctx context.Context) sdkInterceptor.TracerSpan {
+	span := trace.SpanFromContext(ctx)
+	if !span.SpanContext().IsValid() {
+		return nil
+	}
+	return &tracerSpan{Span: span}
+}
+
+func (t *tracer) ContextWithSpan(ctx context.Context, span sdkInterceptor.TracerSpan) context.Context {
+	s, ok := span.(*tracerSpan)
+	if !ok {
+		return ctx
+	}
+	return trace.ContextWithSpan(ctx, s.Span)
+}
+
+//nolint:ireturn // implements sdkInterceptor.Tracer
+func (t *tracer) StartSpan(opts *sdkInterceptor.TracerStartSpanOptions) (sdkInterceptor.TracerSpan, error) {
+	ctx := context.Background()
+	switch parent := opts.Parent.(type) {
+	case nil:
+	case *tracerSpan:
+		ctx = trace.ContextWithSpan(ctx, parent.Span)
+	case *tracerSpanRef:
+		ctx = trace.ContextWithRemoteSpanContext(ctx, parent.SpanContext)
+	default:
+		return nil, fmt.Errorf("unexpected parent span type %T", opts.Parent)
+	}
+
+	attrs := make([]attribute.KeyValue, 0, len(opts.Tags))
+	for k, v := range opts.Tags {
+		attrs = append(attrs, attribute.String(k, v))
+	}
+
+	startOpts := []trace.SpanStartOption{
+		trace.WithAttributes(attrs...),
+		trace.WithSpanKind(spanKind(opts.Operation)),
+	}
+	if !opts.Time.IsZero() {
+		startOpts = append(startOpts, trace.WithTimestamp(opts.Time))
+	}
+
+	_, span := t.tracer.Start(ctx, t.SpanName(opts), startOpts...)
+	return &tracerSpan{Span: span}, nil
+}
+
+func (s *tracerSpan) Finish(opts *sdkInterceptor.TracerFinishSpanOptions) {
+	if opts.Error != nil {
+		s.RecordError(opts.Error)
+		s.SetStatus(codes.Error, opts.Error.Error())
+	}
+	s.End()
+}
+
+// spanKind maps the operations of the SDK tracing interceptor to OpenTelemetry span kinds.
+func spanKind(operation string) trace.SpanKind {
+	switch operation {
+	case "RunWorkflow", "RunActivity", "HandleSignal", "HandleQuery":
+		return trace.SpanKindServer
+	default:
+		return trace.SpanKindClient
+	}
+}
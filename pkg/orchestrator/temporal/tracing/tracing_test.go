@@ -0,0 +1,101 @@
+package tracing_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/sdk/converter"
+	sdkInterceptor "go.temporal.io/sdk/interceptor"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/tracing"
+)
+
+func chargeActivity(_ context.Context, amount int) (int, error) {
+	return amount, nil
+}
+
+func childWorkflow(ctx workflow.Context, amount int) (int, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{StartToCloseTimeout: time.Minute})
+	var charged int
+	err := workflow.ExecuteActivity(ctx, chargeActivity, amount).Get(ctx, &charged)
+	return charged, err
+}
+
+func parentWorkflow(ctx workflow.Context) (int, error) {
+	workflow.GetSignalChannel(ctx, "approve").Receive(ctx, nil)
+	var charged int
+	err := workflow.ExecuteChildWorkflow(ctx, childWorkflow, 42).Get(ctx, &charged)
+	return charged, err
+}
+
+func TestInterceptor(t *testing.T) {
+	t.Parallel()
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	// the caller of the workflow, e.g. an HTTP handler, owns the root span.
+	_, root := provider.Tracer("test").Start(context.Background(), "http request")
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(trace.ContextWithSpan(context.Background(), root), carrier)
+	headerPayload, err := converter.GetDefaultDataConverter().ToPayload(map[string]string(carrier))
+	require.NoError(t, err)
+
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.SetWorkerOptions(worker.Options{
+		Interceptors: []sdkInterceptor.WorkerInterceptor{
+			tracing.NewInterceptor(&tracing.Config{TracerProvider: provider}),
+		},
+	})
+	env.SetHeader(&commonpb.Header{Fields: map[string]*commonpb.Payload{tracing.HeaderKey: headerPayload}})
+	env.RegisterWorkflow(parentWorkflow)
+	env.RegisterWorkflow(childWorkflow)
+	env.RegisterActivity(chargeActivity)
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow("approve", nil)
+	}, time.Second)
+
+	env.ExecuteWorkflow(parentWorkflow)
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	root.End()
+
+	spans := map[string]tracetest.SpanStub{}
+	for _, span := range exporter.GetSpans() {
+		spans[span.Name] = span
+	}
+	// signals are parented by the trace of the signaling client, which is absent in the test environment.
+	assert.Contains(t, spans, "HandleSignal:approve")
+	for _, name := range []string{
+		"RunWorkflow:parentWorkflow",
+		"StartChildWorkflow:childWorkflow",
+		"RunWorkflow:childWorkflow",
+		"StartActivity:chargeActivity",
+		"RunActivity:chargeActivity",
+	} {
+		span, ok := spans[name]
+		if !assert.True(t, ok, "missing span %s", name) {
+			continue
+		}
+		assert.Equal(t, root.SpanContext().TraceID(), span.SpanContext.TraceID(), "span %s not in the caller's trace", name)
+	}
+
+	assert.Equal(t, root.SpanContext().SpanID(), spans["RunWorkflow:parentWorkflow"].Parent.SpanID())
+	assert.Equal(t, trace.SpanKindServer, spans["RunActivity:chargeActivity"].SpanKind)
+	assert.Equal(t, trace.SpanKindClient, spans["StartActivity:chargeActivity"].SpanKind)
+	assert.Equal(t,
+		spans["StartActivity:chargeActivity"].SpanContext.SpanID(),
+		spans["RunActivity:chargeActivity"].Parent.SpanID(),
+	)
+}
@@ -2,6 +2,7 @@ package temporal
 
 import (
 	"fmt"
+	"github.com/nash-567/goTemporalLoom/pkg/logger"
 	logModel "github.com/nash-567/goTemporalLoom/pkg/logger/model"
 	"github.com/nash-567/goTemporalLoom/pkg/orchestrator/temporal/model"
 	"go.temporal.io/sdk/workflow"
@@ -9,11 +10,31 @@ import (
 )
 
 // workflowEngine encapsulates workflow functionalities that are provided by Temporal through dangling functions.
-type workflowEngine struct{}
+type workflowEngine struct {
+	log *logger.SlogLogger
+}
+
+// NewWorkflowEngine returns the model.WorkflowEngine backed by the Temporal SDK.
+// Loggers returned by GetLogger write through log.
+//
+//nolint:ireturn // workflows only depend on the model interface
+func NewWorkflowEngine(log *logger.SlogLogger) model.WorkflowEngine {
+	return &workflowEngine{log: log}
+}
 
 // GetLogger returns a logger to be used in the workflow's context.
-func (we *workflowEngine) GetLogger(ctx model.Context) logModel.KeyValLogger {
-	return workflow.GetLogger(model.ToTemporalContext(ctx))
+//
+//nolint:ireturn // implements model.WorkflowEngine interface
+func (we *workflowEngine) GetLogger(ctx model.Context) logModel.Logger {
+	temporalCtx := model.ToTemporalContext(ctx)
+	info := workflow.GetInfo(temporalCtx)
+	isReplaying := func() bool { return workflow.IsReplaying(temporalCtx) }
+	return logger.NewReplaySafeLogger(we.log, isReplaying).WithFields(logModel.Fields{
+		"workflow_id":   info.WorkflowExecution.ID,
+		"run_id":        info.WorkflowExecution.RunID,
+		"workflow_type": info.WorkflowType.Name,
+		"attempt":       info.Attempt,
+	})
 }
 
 // ExecuteActivity executes a workflow activity.
@@ -41,6 +62,11 @@ func (we *workflowEngine) Sleep(ctx model.Context, d time.Duration) error {
 	return nil
 }
 
+// Now returns the current workflow time.
+func (we *workflowEngine) Now(ctx model.Context) time.Time {
+	return workflow.Now(model.ToTemporalContext(ctx))
+}
+
 // GetSignalChannel returns a channel to receive signals for the workflow.
 func (we *workflowEngine) GetSignalChannel(ctx model.Context, signalName string) model.ReceiveChannel {
 	return newReceiveChannel(workflow.GetSignalChannel(model.ToTemporalContext(ctx), signalName))
@@ -57,3 +83,21 @@ func (we *workflowEngine) WithActivityOptions(
 		),
 	)
 }
+
+// ExecuteChildWorkflow starts a new child workflow execution.
+func (we *workflowEngine) ExecuteChildWorkflow(
+	ctx model.Context,
+	options model.ChildWorkflowOptions,
+	childWorkflow model.WorkflowDescriptor,
+	args model.Params,
+) (model.ChildWorkflowFuture, error) {
+	workflowID, err := childWorkflow.GenerateWorkflowID(args)
+	if err != nil {
+		return nil, fmt.Errorf("generate child workflow ID: %w", err)
+	}
+	childCtx := workflow.WithChildOptions(
+		model.ToTemporalContext(ctx),
+		model.ToTemporalChildWorkflowOptions(workflowID, &options),
+	)
+	return newChildWorkflowFuture(workflow.ExecuteChildWorkflow(childCtx, childWorkflow.Name(), args)), nil
+}
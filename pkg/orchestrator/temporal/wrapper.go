@@ -25,6 +25,11 @@ type (
 		workflow.Future
 	}
 
+	// childWorkflowFutureWrapper is a wrapper around a Temporal ChildWorkflowFuture.
+	childWorkflowFutureWrapper struct {
+		workflow.ChildWorkflowFuture
+	}
+
 	// contextWrapper is a wrapper around a Temporal Context.
 	contextWrapper struct {
 		workflow.Context
@@ -64,6 +69,32 @@ func (f futureWrapper) Get(ctx model.Context, valuePtr interface{}) error {
 
 // === Future Methods End ===
 
+// === ChildWorkflowFuture Methods Start ===
+func newChildWorkflowFuture(f workflow.ChildWorkflowFuture) model.ChildWorkflowFuture {
+	return &childWorkflowFutureWrapper{f}
+}
+
+func (f *childWorkflowFutureWrapper) Get(ctx model.Context, valuePtr interface{}) error {
+	if err := f.ChildWorkflowFuture.Get(model.ToTemporalContext(ctx), valuePtr); err != nil {
+		return fmt.Errorf("decode child workflow result: %w", err)
+	}
+	return nil
+}
+
+func (f *childWorkflowFutureWrapper) GetChildWorkflowExecution() model.Future {
+	return newFuture(f.ChildWorkflowFuture.GetChildWorkflowExecution())
+}
+
+func (f *childWorkflowFutureWrapper) SignalChildWorkflow(
+	ctx model.Context,
+	signalName string,
+	data interface{},
+) model.Future {
+	return newFuture(f.ChildWorkflowFuture.SignalChildWorkflow(model.ToTemporalContext(ctx), signalName, data))
+}
+
+// === ChildWorkflowFuture Methods End ===
+
 // === ReceiveChannel Methods Start ===
 func (r *receiveChannelWrapper) Receive(ctx model.Context, valuePtr interface{}) bool {
 	return r.ReceiveChannel.Receive(model.ToTemporalContext(ctx), valuePtr)